@@ -0,0 +1,241 @@
+package aezeed
+
+// This file implements LND's aezeed cipher seed as detailed by
+// aezeed spec: https://github.com/lightningnetwork/lnd/tree/master/aezeed
+// A 24 word English sentence holds a version, the AEZ enciphered
+// internal version, birthday and entropy, the scrypt salt and a CRC32.
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"hash/crc32"
+	"time"
+	"github.com/32bitkid/bitreader"
+	"github.com/Yawning/aez"
+	"golang.org/x/crypto/scrypt"
+	"gobip39"
+	"gobip39/wordlist"
+)
+
+const (
+	CipherSeedVersion uint8 = 0
+	EntropySize = 16
+	SaltSize = 5
+	// Internal version, birthday and entropy
+	DecipheredSize = 1 + 2 + EntropySize
+	EncipheredSize = 33
+	// Bytes of authentication AEZ adds to the plaintext
+	CipherTextExpansion = 4
+	SentenceSize = EncipheredSize * 8 / gobip39.WordBitLength
+	DefaultPassphrase = "aezeed"
+	saltOffset = EncipheredSize - 4 - SaltSize
+	checksumOffset = EncipheredSize - 4
+	scryptN = 32768
+	scryptR = 8
+	scryptP = 1
+	keyLength = 32
+)
+
+// Day 0 of aezeed birthdays
+var BitcoinGenesisDate = time.Unix(1231006505, 0)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Error type specifically for aezeed errors
+type aezeedError struct {
+	Message string
+}
+
+func (err aezeedError) Error() string {
+	return err.Message
+}
+
+var (
+	// Returned when a sentence passes its checksum but fails to decipher
+	ErrInvalidPassphrase = aezeedError{Message: "Invalid passphrase for aezeed sentence."}
+	// Returned when a sentence fails its checksum
+	ErrInvalidChecksum = aezeedError{Message: "Checksum of aezeed sentence is invalid."}
+)
+
+// Type to wrap the plaintext of an aezeed: the wallet's internal version,
+// its birthday in days since the Bitcoin genesis block, its entropy and the
+// salt of its passphrase.
+type CipherSeed struct {
+	InternalVersion uint8
+	Birthday uint16
+	Entropy [EntropySize]byte
+	Salt [SaltSize]byte
+}
+
+// Create a CipherSeed of 128-bit Entropy for a wallet born at a time, with
+// a random salt.
+// An error is returned if the Entropy is not 128 bits, the birthday is
+// outside the range of days from the genesis block, or the system's
+// randomness source fails.
+func New(internalVersion uint8, ent gobip39.Entropy, birthday time.Time) (CipherSeed, error) {
+	if (len(ent.Data) != EntropySize) {
+		return CipherSeed{}, aezeedError{Message: "aezeed entropy must be 128 bits."}
+	}
+
+	days := birthday.Sub(BitcoinGenesisDate) / (24 * time.Hour)
+
+	if (birthday.Before(BitcoinGenesisDate) || days > 0xFFFF) {
+		return CipherSeed{}, aezeedError{Message: "Birthday is outside of the aezeed range."}
+	}
+
+	seed := CipherSeed{InternalVersion: internalVersion, Birthday: uint16(days)}
+	copy(seed.Entropy[:], ent.Data)
+
+	if _, err := rand.Read(seed.Salt[:]); err != nil {
+		return CipherSeed{}, aezeedError{Message: err.Error()}
+	}
+
+	return seed, nil
+}
+
+// The seed's Entropy.
+func (seed CipherSeed) GetEntropy() gobip39.Entropy {
+	ent, _ := gobip39.GetEntropyFromBytes(append([]byte{}, seed.Entropy[:]...))
+
+	return ent
+}
+
+// The day the wallet was born.
+func (seed CipherSeed) BirthdayTime() time.Time {
+	return BitcoinGenesisDate.Add(time.Duration(seed.Birthday) * 24 * time.Hour)
+}
+
+// Encipher the seed under a passphrase, "aezeed" if empty.
+// An error is returned if key derivation fails.
+func (seed CipherSeed) Encipher(passphrase string) ([EncipheredSize]byte, error) {
+	return seed.encipher(passphrase, scryptN)
+}
+
+// Helper method that enciphers the seed with scrypt's cost set to n.
+func (seed CipherSeed) encipher(passphrase string, n int) ([EncipheredSize]byte, error) {
+	var enciphered [EncipheredSize]byte
+
+	key, err := deriveKey(passphrase, seed.Salt, n)
+
+	if (err != nil) { return enciphered, err }
+
+	plaintext := make([]byte, 0, DecipheredSize)
+	plaintext = append(plaintext, seed.InternalVersion)
+	plaintext = binary.BigEndian.AppendUint16(plaintext, seed.Birthday)
+	plaintext = append(plaintext, seed.Entropy[:]...)
+
+	enciphered[0] = CipherSeedVersion
+	copy(enciphered[1:saltOffset], aez.Encrypt(key, nil, [][]byte{additionalData(CipherSeedVersion, seed.Salt)}, CipherTextExpansion, plaintext, nil))
+	copy(enciphered[saltOffset:checksumOffset], seed.Salt[:])
+	binary.BigEndian.PutUint32(enciphered[checksumOffset:], crc32.Checksum(enciphered[:checksumOffset], crcTable))
+
+	return enciphered, nil
+}
+
+// Encipher the seed under a passphrase and write it as 24 English words.
+// An error is returned if enciphering fails.
+func (seed CipherSeed) GetSentence(passphrase string) ([]string, error) {
+	return seed.getSentence(passphrase, scryptN)
+}
+
+// Helper method that writes the seed as words with scrypt's cost set to n.
+func (seed CipherSeed) getSentence(passphrase string, n int) ([]string, error) {
+	enciphered, err := seed.encipher(passphrase, n)
+
+	if (err != nil) { return []string{}, err }
+
+	bitReader := bitreader.NewBitReader(bytes.NewReader(enciphered[:]))
+	sentence := make([]string, SentenceSize)
+
+	for i := range sentence {
+		index, readErr := bitReader.Read32(gobip39.WordBitLength)
+
+		if (readErr != nil) { return []string{}, aezeedError{Message: readErr.Error()} }
+
+		sentence[i], err = wordlist.English.GetWordAt(index)
+
+		if (err != nil) { return []string{}, aezeedError{Message: err.Error()} }
+	}
+
+	return sentence, nil
+}
+
+// Decipher a 24 word English aezeed sentence under a passphrase, "aezeed"
+// if empty.
+// An error is returned if the sentence is malformed or has an unknown
+// version, ErrInvalidChecksum if its checksum fails, and
+// ErrInvalidPassphrase if it fails to decipher.
+func Decipher(sentence []string, passphrase string) (CipherSeed, error) {
+	return decipher(sentence, passphrase, scryptN)
+}
+
+// Helper method that deciphers a sentence with scrypt's cost set to n.
+func decipher(sentence []string, passphrase string, n int) (CipherSeed, error) {
+	if (len(sentence) != SentenceSize) {
+		return CipherSeed{}, aezeedError{Message: "aezeed sentences have 24 words."}
+	}
+
+	var enciphered [EncipheredSize]byte
+
+	for i, word := range sentence {
+		index := wordlist.English.FindWord(word)
+
+		if (index < 0) {
+			return CipherSeed{}, aezeedError{Message: "Word '" + word + "' is not in the English wordlist."}
+		}
+
+		for bit := 0; bit < gobip39.WordBitLength; bit++ {
+			if (index >> uint(gobip39.WordBitLength - 1 - bit) & 1 == 1) {
+				position := i * gobip39.WordBitLength + bit
+				enciphered[position / 8] |= 1 << uint(7 - position % 8)
+			}
+		}
+	}
+
+	if (enciphered[0] != CipherSeedVersion) {
+		return CipherSeed{}, aezeedError{Message: "Unknown aezeed version."}
+	}
+
+	if (crc32.Checksum(enciphered[:checksumOffset], crcTable) != binary.BigEndian.Uint32(enciphered[checksumOffset:])) {
+		return CipherSeed{}, ErrInvalidChecksum
+	}
+
+	var seed CipherSeed
+	copy(seed.Salt[:], enciphered[saltOffset:checksumOffset])
+
+	key, keyErr := deriveKey(passphrase, seed.Salt, n)
+
+	if (keyErr != nil) { return CipherSeed{}, keyErr }
+
+	plaintext, ok := aez.Decrypt(key, nil, [][]byte{additionalData(enciphered[0], seed.Salt)}, CipherTextExpansion, enciphered[1:saltOffset], nil)
+
+	if (!ok || len(plaintext) != DecipheredSize) {
+		return CipherSeed{}, ErrInvalidPassphrase
+	}
+
+	seed.InternalVersion = plaintext[0]
+	seed.Birthday = binary.BigEndian.Uint16(plaintext[1:3])
+	copy(seed.Entropy[:], plaintext[3:])
+
+	return seed, nil
+}
+
+// Helper method that stretches the passphrase with scrypt. Only tests
+// lower the cost n below scryptN, to check LND's vectors quickly.
+func deriveKey(passphrase string, salt [SaltSize]byte, n int) ([]byte, error) {
+	if (passphrase == "") {
+		passphrase = DefaultPassphrase
+	}
+
+	key, err := scrypt.Key([]byte(passphrase), salt[:], n, scryptR, scryptP, keyLength)
+
+	if (err != nil) { return []byte{}, aezeedError{Message: err.Error()} }
+
+	return key, nil
+}
+
+// Additional data authenticated by AEZ: the version and salt.
+func additionalData(version uint8, salt [SaltSize]byte) []byte {
+	return append([]byte{version}, salt[:]...)
+}
@@ -0,0 +1,52 @@
+package aezeed
+
+// This file checks LND's version0TestVectors, which LND computes with
+// scrypt's N lowered to 16. It lives inside the package, as the cost can
+// only be lowered through unexported helpers.
+
+import (
+	"testing"
+	"strings"
+	"time"
+)
+
+const lndScryptN = 16
+
+var lndEntropy = [EntropySize]byte{
+	0x81, 0xb6, 0x37, 0xd8, 0x63, 0x59, 0xe6, 0x96,
+	0x0d, 0xe7, 0x95, 0xe4, 0x1e, 0x0b, 0x4c, 0xfd,
+}
+
+// "salt1"
+var lndSalt = [SaltSize]byte{0x73, 0x61, 0x6c, 0x74, 0x31}
+
+var lndVectors = []struct {
+	birthday time.Time
+	passphrase string
+	sentence string
+}{
+	{BitcoinGenesisDate, "", "ability liquid travel stem barely drastic pact cupboard apple thrive morning oak feature tissue couch old math inform success suggest drink motion know royal"},
+	{time.Unix(1521799345, 0), "!very_safe_55345_password*", "able tree stool crush transfer cloud cross three profit outside hen citizen plate ride require leg siren drum success suggest drink require fiscal upgrade"},
+}
+
+func TestAezeed_GetSentence_MatchesLNDVectors(t *testing.T) {
+	for _, vector := range lndVectors {
+		seed := CipherSeed{InternalVersion: 0, Birthday: uint16(vector.birthday.Sub(BitcoinGenesisDate) / (24 * time.Hour)), Entropy: lndEntropy, Salt: lndSalt}
+
+		sentence, err := seed.getSentence(vector.passphrase, lndScryptN)
+
+		if (err != nil) {
+			t.Fatal("Failed to encipher aezeed:", err.Error())
+		}
+
+		if actual := strings.Join(sentence, " "); actual != vector.sentence {
+			t.Error("Expected aezeed", actual, "to equal", vector.sentence)
+		}
+
+		deciphered, decipherErr := decipher(strings.Split(vector.sentence, " "), vector.passphrase, lndScryptN)
+
+		if (decipherErr != nil || deciphered.Entropy != lndEntropy || deciphered.Birthday != seed.Birthday) {
+			t.Error("Expected", vector.sentence, "to decipher into the vector's seed.")
+		}
+	}
+}
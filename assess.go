@@ -0,0 +1,139 @@
+package gobip39
+
+// This file assesses Entropy for obvious weaknesses, so importing a
+// phrase such as "abandon ... about" can warn that its wallet is not
+// safe to use.
+
+import (
+	"strings"
+	"gobip39/wordlist"
+)
+
+// Weakness found in Entropy by Assess
+type Weakness uint8
+
+const (
+	// Every byte is the same, as with all-zero entropy
+	WeaknessConstant Weakness = iota
+	// The bytes repeat a shorter block, such as 7f7f... or 8080...
+	WeaknessRepeating
+	// The bytes or word indices step by a constant, such as 00 01 02 ...
+	WeaknessSequential
+	// The sentence uses few distinct words
+	WeaknessFewDistinctWords
+	// The sentence is a published test vector or tool default
+	WeaknessKnownMnemonic
+)
+
+func (weakness Weakness) String() string {
+	switch weakness {
+	case WeaknessConstant:
+		return "Entropy is a single repeated byte."
+	case WeaknessRepeating:
+		return "Entropy repeats a shorter pattern."
+	case WeaknessSequential:
+		return "Entropy or its words follow a sequence."
+	case WeaknessFewDistinctWords:
+		return "Sentence has few distinct words."
+	case WeaknessKnownMnemonic:
+		return "Sentence is a publicly known mnemonic."
+	}
+
+	return "Unknown weakness."
+}
+
+// Assess the Entropy for obvious weaknesses, returning those found (none
+// if it looks random). Random entropy can also trip these checks, but
+// only with negligible probability. Checks of the sentence are skipped
+// if the Entropy cannot form a Mnemonic.
+func (ent Entropy) Assess() []Weakness {
+	weaknesses := []Weakness{}
+
+	if (len(ent.Data) == 0) { return weaknesses }
+
+	period := getPeriod(ent.Data)
+
+	if (period == 1) {
+		weaknesses = append(weaknesses, WeaknessConstant)
+	} else if (period <= len(ent.Data) / 2) {
+		weaknesses = append(weaknesses, WeaknessRepeating)
+	}
+
+	mnemonic, err := GetMnemonicFromEntropy(Entropy{ent.Size, append([]byte{}, ent.Data...)})
+
+	if (err != nil) { return weaknesses }
+
+	defer mnemonic.Destroy()
+
+	// The last word holds the checksum, so only the others are checked
+	indices := mnemonic.Sentence[:len(mnemonic.Sentence) - 1]
+
+	data := make([]uint32, len(ent.Data))
+
+	for i, value := range ent.Data {
+		data[i] = uint32(value)
+	}
+
+	if (isSequential(data, 1 << 8) || isSequential(indices, 1 << WordBitLength)) {
+		weaknesses = append(weaknesses, WeaknessSequential)
+	}
+
+	distinct := map[uint32]bool{}
+
+	for _, index := range mnemonic.Sentence {
+		distinct[index] = true
+	}
+
+	// 3 or more repeats among random words are rare (< 1 in 1000)
+	if (len(distinct) <= len(mnemonic.Sentence) * 2 / 3) {
+		weaknesses = append(weaknesses, WeaknessFewDistinctWords)
+	}
+
+	sentence, err := mnemonic.GetSentenceFrom(wordlist.English)
+
+	if (err == nil && isKnownMnemonic(strings.Join(sentence, " "))) {
+		weaknesses = append(weaknesses, WeaknessKnownMnemonic)
+	}
+
+	return weaknesses
+}
+
+// Helper method to get the length of the shortest block that data
+// repeats, which is the length of data if it does not repeat.
+func getPeriod(data []byte) int {
+	for period := 1; period < len(data); period++ {
+		repeats := true
+
+		for i := period; i < len(data) && repeats; i++ {
+			repeats = data[i] == data[i - period]
+		}
+
+		if (repeats) { return period }
+	}
+
+	return len(data)
+}
+
+// Helper method that reports whether values step by a constant, non-zero
+// difference, wrapping around at modulus.
+func isSequential(values []uint32, modulus uint32) bool {
+	if (len(values) < 3) { return false }
+
+	step := (values[1] + modulus - values[0]) % modulus
+
+	for i := 2; i < len(values) && step != 0; i++ {
+		if ((values[i] + modulus - values[i - 1]) % modulus != step) { return false }
+	}
+
+	return step != 0
+}
+
+// Helper method that reports whether an English sentence is bundled in
+// knownMnemonics.
+func isKnownMnemonic(sentence string) bool {
+	for _, known := range knownMnemonics {
+		if (known == sentence) { return true }
+	}
+
+	return false
+}
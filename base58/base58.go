@@ -0,0 +1,125 @@
+package base58
+
+// This file implements the Base58 encoding used by Bitcoin and
+// related chains for addresses and serialized keys.
+
+import (
+	"bytes"
+	SHA256 "crypto/sha256"
+	"math/big"
+)
+
+const (
+	Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	ChecksumLength = 4
+)
+
+// Error type specifically for base58 errors
+type base58Error struct {
+	Message string
+}
+
+func (err base58Error) Error() string {
+	return err.Message
+}
+
+// Encode data as a Base58 string. Each leading zero byte
+// is encoded as a leading '1'.
+func Encode(data []byte) string {
+	number := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	remainder := new(big.Int)
+
+	encoded := []byte{}
+
+	for number.Sign() > 0 {
+		number.DivMod(number, radix, remainder)
+		encoded = append(encoded, Alphabet[remainder.Int64()])
+	}
+
+	// Leading zero bytes would otherwise be lost in the integer conversion
+	for i := 0; i < len(data) && data[i] == 0; i++ {
+		encoded = append(encoded, Alphabet[0])
+	}
+
+	// Digits were produced least significant first
+	for i, j := 0, len(encoded) - 1; i < j; i, j = i + 1, j - 1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(encoded)
+}
+
+// Decode a Base58 string back into bytes.
+// An error is returned if the string contains a character
+// outside of the Base58 alphabet.
+func Decode(encoded string) ([]byte, error) {
+	number := new(big.Int)
+	radix := big.NewInt(58)
+
+	for i := 0; i < len(encoded); i++ {
+		digit := indexOf(encoded[i])
+
+		if (digit < 0) {
+			return []byte{}, base58Error{Message: "Invalid Base58 character '" + string(encoded[i]) + "'."}
+		}
+
+		number.Mul(number, radix)
+		number.Add(number, big.NewInt(int64(digit)))
+	}
+
+	// Restore the leading zero bytes
+	leadingZeros := 0
+	for leadingZeros < len(encoded) && encoded[leadingZeros] == Alphabet[0] {
+		leadingZeros++
+	}
+
+	return append(make([]byte, leadingZeros), number.Bytes()...), nil
+}
+
+// Helper method to find a character's digit value.
+// Returns -1 if the character is not in the alphabet.
+func indexOf(character byte) int {
+	for i := 0; i < len(Alphabet); i++ {
+		if (Alphabet[i] == character) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Encode data as Base58Check: the data followed by the first
+// 4 bytes of its double SHA256 digest.
+func CheckEncode(data []byte) string {
+	return Encode(append(data[:len(data):len(data)], checksum(data)...))
+}
+
+// Decode a Base58Check string, returning the data without its checksum.
+// An error is returned if the string is not valid Base58, is too short
+// to hold a checksum, or its checksum does not match.
+func CheckDecode(encoded string) ([]byte, error) {
+	decoded, err := Decode(encoded)
+
+	if (err != nil) { return []byte{}, err }
+
+	if (len(decoded) < ChecksumLength) {
+		return []byte{}, base58Error{Message: "Base58Check data is too short."}
+	}
+
+	data := decoded[:len(decoded) - ChecksumLength]
+
+	if (!bytes.Equal(checksum(data), decoded[len(decoded) - ChecksumLength:])) {
+		return []byte{}, base58Error{Message: "Base58Check checksum does not match."}
+	}
+
+	return data, nil
+}
+
+// Helper method to compute the Base58Check checksum of data.
+func checksum(data []byte) []byte {
+	first := SHA256.Sum256(data)
+	second := SHA256.Sum256(first[:])
+
+	return second[:ChecksumLength]
+}
@@ -0,0 +1,211 @@
+package bech32
+
+// This file implements the Bech32 encoding as detailed by
+// BIP-0173 spec: https://github.com/bitcoin/bips/blob/master/bip-0173.mediawiki
+
+import (
+	"strings"
+)
+
+const (
+	Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	ChecksumLength = 6
+	MaximumLength = 90
+)
+
+var generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// Error type specifically for bech32 errors
+type bech32Error struct {
+	Message string
+}
+
+func (err bech32Error) Error() string {
+	return err.Message
+}
+
+// Encode 8-bit data under a human-readable prefix.
+// An error is returned if the prefix is empty or contains
+// characters outside of the US-ASCII range [33, 126].
+func Encode(hrp string, data []byte) (string, error) {
+	groups, err := ConvertBits(data, 8, 5, true)
+
+	if (err != nil) { return "", err }
+
+	return EncodeGroups(hrp, groups)
+}
+
+// Encode 5-bit groups under a human-readable prefix.
+// An error is returned if the prefix is invalid or a
+// group is larger than 5 bits.
+func EncodeGroups(hrp string, groups []byte) (string, error) {
+	if (len(hrp) == 0) {
+		return "", bech32Error{Message: "Human-readable prefix must not be empty."}
+	}
+
+	for i := 0; i < len(hrp); i++ {
+		if (hrp[i] < 33 || hrp[i] > 126) {
+			return "", bech32Error{Message: "Human-readable prefix contains an invalid character."}
+		}
+	}
+
+	hrp = strings.ToLower(hrp)
+	checksum := createChecksum(hrp, groups)
+
+	var builder strings.Builder
+	builder.WriteString(hrp)
+	builder.WriteByte('1')
+
+	// Copy first, as appending to groups could write into the caller's array
+	combined := append(append(make([]byte, 0, len(groups) + len(checksum)), groups...), checksum...)
+
+	for _, group := range combined {
+		if (group > 31) {
+			return "", bech32Error{Message: "Group is larger than 5 bits."}
+		}
+
+		builder.WriteByte(Charset[group])
+	}
+
+	return builder.String(), nil
+}
+
+// Decode a Bech32 string into its human-readable prefix and 8-bit data.
+// An error is returned if the string is malformed, its checksum
+// is invalid, or its data is not valid padded 8-bit data.
+func Decode(encoded string) (string, []byte, error) {
+	hrp, groups, err := DecodeGroups(encoded)
+
+	if (err != nil) { return "", []byte{}, err }
+
+	data, convertErr := ConvertBits(groups, 5, 8, false)
+
+	if (convertErr != nil) { return "", []byte{}, convertErr }
+
+	return hrp, data, nil
+}
+
+// Decode a Bech32 string into its human-readable prefix and 5-bit groups,
+// excluding the checksum.
+// An error is returned if the string is malformed or its checksum is invalid.
+func DecodeGroups(encoded string) (string, []byte, error) {
+	if (len(encoded) > MaximumLength) {
+		return "", []byte{}, bech32Error{Message: "Bech32 string exceeds 90 characters."}
+	}
+
+	if (strings.ToLower(encoded) != encoded && strings.ToUpper(encoded) != encoded) {
+		return "", []byte{}, bech32Error{Message: "Bech32 string has mixed case."}
+	}
+
+	encoded = strings.ToLower(encoded)
+	separator := strings.LastIndexByte(encoded, '1')
+
+	if (separator < 1 || separator + ChecksumLength + 1 > len(encoded)) {
+		return "", []byte{}, bech32Error{Message: "Bech32 separator is missing or misplaced."}
+	}
+
+	hrp := encoded[:separator]
+
+	for i := 0; i < len(hrp); i++ {
+		if (hrp[i] < 33 || hrp[i] > 126) {
+			return "", []byte{}, bech32Error{Message: "Human-readable prefix contains an invalid character."}
+		}
+	}
+
+	groups := make([]byte, len(encoded) - separator - 1)
+
+	for i := range groups {
+		index := strings.IndexByte(Charset, encoded[separator + 1 + i])
+
+		if (index < 0) {
+			return "", []byte{}, bech32Error{Message: "Bech32 string contains an invalid character."}
+		}
+
+		groups[i] = byte(index)
+	}
+
+	if (polymod(append(expandHRP(hrp), groups...)) != 1) {
+		return "", []byte{}, bech32Error{Message: "Bech32 checksum is invalid."}
+	}
+
+	return hrp, groups[:len(groups) - ChecksumLength], nil
+}
+
+// Regroup data from groups of fromBits bits into groups of toBits bits.
+// When pad is true, a final partial group is zero padded; otherwise
+// an error is returned if leftover bits are non-zero or too many.
+func ConvertBits(data []byte, fromBits uint, toBits uint, pad bool) ([]byte, error) {
+	var accumulator uint32
+	var bits uint
+	maximum := uint32(1) << toBits - 1
+
+	converted := []byte{}
+
+	for _, value := range data {
+		if (uint32(value) >> fromBits != 0) {
+			return []byte{}, bech32Error{Message: "Value is larger than the group size."}
+		}
+
+		accumulator = accumulator << fromBits | uint32(value)
+		bits += fromBits
+
+		for bits >= toBits {
+			bits -= toBits
+			converted = append(converted, byte(accumulator >> bits & maximum))
+		}
+	}
+
+	if (pad) {
+		if (bits > 0) {
+			converted = append(converted, byte(accumulator << (toBits - bits) & maximum))
+		}
+	} else if (bits >= fromBits || accumulator << (toBits - bits) & maximum != 0) {
+		return []byte{}, bech32Error{Message: "Invalid padding."}
+	}
+
+	return converted, nil
+}
+
+// Helper method to compute the BCH checksum over values.
+func polymod(values []byte) uint32 {
+	checksum := uint32(1)
+
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum & 0x1ffffff) << 5 ^ uint32(value)
+
+		for i := 0; i < 5; i++ {
+			if (top >> uint(i) & 1 == 1) {
+				checksum ^= generator[i]
+			}
+		}
+	}
+
+	return checksum
+}
+
+// Helper method to expand the human-readable prefix for checksumming.
+func expandHRP(hrp string) []byte {
+	expanded := make([]byte, len(hrp) * 2 + 1)
+
+	for i := 0; i < len(hrp); i++ {
+		expanded[i] = hrp[i] >> 5
+		expanded[len(hrp) + 1 + i] = hrp[i] & 31
+	}
+
+	return expanded
+}
+
+// Helper method to create the 6 group checksum for prefix and groups.
+func createChecksum(hrp string, groups []byte) []byte {
+	values := append(expandHRP(hrp), groups...)
+	values = append(values, make([]byte, ChecksumLength)...)
+	mod := polymod(values) ^ 1
+
+	checksum := make([]byte, ChecksumLength)
+	for i := 0; i < ChecksumLength; i++ {
+		checksum[i] = byte(mod >> uint(5 * (5 - i)) & 31)
+	}
+
+	return checksum
+}
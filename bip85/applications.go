@@ -0,0 +1,182 @@
+package bip85
+
+// This file implements the BIP-0085 applications which turn derived
+// entropy into child mnemonics, keys and passwords.
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"gobip39"
+	"gobip39/base58"
+	"gobip39/slip10"
+	"gobip39/wordlist"
+)
+
+const (
+	base85Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz!#$%&()*+-;<=>?@^_`{|}~"
+	wifVersion = 0x80
+)
+
+// BIP-0085 language codes, keyed by Wordlist.Language()
+var languageCodes = map[string]uint32{
+	"English": 0,
+	"Japanese": 1,
+	"Korean": 2,
+	"Spanish": 3,
+	"Chinese (Simplified)": 4,
+	"Chinese (Traditional)": 5,
+	"French": 6,
+	"Italian": 7,
+	"Czech": 8,
+	"Portuguese": 9,
+}
+
+// Derive a child Mnemonic of 12, 18 or 24 words for a Wordlist's language
+// at m/83696968'/39'/language'/words'/index'. The Mnemonic's indices are
+// meant to be read back through the same Wordlist.
+// An error is returned if the word count or language is unsupported,
+// or derivation fails.
+func DeriveMnemonic(master slip10.Key, wl wordlist.Wordlist, words uint32, index uint32) (gobip39.Mnemonic, error) {
+	if (words != 12 && words != 18 && words != 24) {
+		return gobip39.Mnemonic{}, bip85Error{Message: "Word count must be 12, 18 or 24."}
+	}
+
+	language, ok := languageCodes[wl.Language()]
+
+	if (!ok) {
+		return gobip39.Mnemonic{}, bip85Error{Message: "Language '" + wl.Language() + "' has no BIP-0085 code."}
+	}
+
+	entropy, err := DeriveEntropy(master, BIP39Application, language, words, index)
+
+	if (err != nil) { return gobip39.Mnemonic{}, err }
+
+	defer gobip39.Wipe(entropy)
+
+	// Words carry 11 bits each, of which entropy fills 32 out of every 33.
+	// The prefix is copied so the whole derived buffer can be wiped.
+	data := append([]byte{}, entropy[:words * 4 / 3]...)
+
+	mnemonic, err := gobip39.GetMnemonicFromBytes(data)
+
+	if (err != nil) {
+		gobip39.Wipe(data)
+		return gobip39.Mnemonic{}, err
+	}
+
+	return mnemonic, nil
+}
+
+// Derive numBytes of hex encoded entropy at m/83696968'/128169'/numBytes'/index'.
+// An error is returned if numBytes is outside the domain [16, 64] or
+// derivation fails.
+func DeriveHex(master slip10.Key, numBytes uint32, index uint32) (string, error) {
+	if (numBytes < 16 || numBytes > EntropyLength) {
+		return "", bip85Error{Message: "Number of bytes was outside of domain [16, 64]."}
+	}
+
+	entropy, err := DeriveEntropy(master, HexApplication, numBytes, index)
+
+	if (err != nil) { return "", err }
+
+	defer gobip39.Wipe(entropy)
+
+	return hex.EncodeToString(entropy[:numBytes]), nil
+}
+
+// Derive a compressed mainnet WIF private key at m/83696968'/2'/index'.
+// An error is returned if derivation fails.
+func DeriveWIF(master slip10.Key, index uint32) (string, error) {
+	entropy, err := DeriveEntropy(master, WIFApplication, index)
+
+	if (err != nil) { return "", err }
+
+	defer gobip39.Wipe(entropy)
+
+	// Version, 32 byte private key, and the compressed public key marker
+	data := append([]byte{wifVersion}, entropy[:32]...)
+	data = append(data, 0x01)
+
+	defer gobip39.Wipe(data)
+
+	return base58.CheckEncode(data), nil
+}
+
+// Derive an extended private key at m/83696968'/32'/index'. The first 32
+// bytes of entropy become the chain code and the last 32 the private key.
+// An error is returned if derivation fails or the private key is invalid.
+func DeriveXPRV(master slip10.Key, index uint32) (slip10.Key, error) {
+	entropy, err := DeriveEntropy(master, XPRVApplication, index)
+
+	if (err != nil) { return slip10.Key{}, err }
+
+	defer gobip39.Wipe(entropy)
+
+	key := slip10.Key{Curve: slip10.Secp256k1}
+	copy(key.ChainCode[:], entropy[:32])
+	copy(key.PrivateKey[:], entropy[32:])
+
+	// Round trip through serialization to reject keys outside the curve order
+	serialized, serializeErr := key.Serialize()
+
+	if (serializeErr != nil) { return slip10.Key{}, serializeErr }
+
+	return slip10.ParseExtendedKey(serialized)
+}
+
+// Derive a Base64 password of length characters at
+// m/83696968'/707764'/length'/index'.
+// An error is returned if length is outside the domain [20, 86] or
+// derivation fails.
+func DeriveBase64Password(master slip10.Key, length uint32, index uint32) (string, error) {
+	if (length < 20 || length > 86) {
+		return "", bip85Error{Message: "Password length was outside of domain [20, 86]."}
+	}
+
+	entropy, err := DeriveEntropy(master, Base64PasswordApplication, length, index)
+
+	if (err != nil) { return "", err }
+
+	defer gobip39.Wipe(entropy)
+
+	return base64.StdEncoding.EncodeToString(entropy)[:length], nil
+}
+
+// Derive a Base85 password of length characters at
+// m/83696968'/707785'/length'/index'.
+// An error is returned if length is outside the domain [10, 80] or
+// derivation fails.
+func DeriveBase85Password(master slip10.Key, length uint32, index uint32) (string, error) {
+	if (length < 10 || length > 80) {
+		return "", bip85Error{Message: "Password length was outside of domain [10, 80]."}
+	}
+
+	entropy, err := DeriveEntropy(master, Base85PasswordApplication, length, index)
+
+	if (err != nil) { return "", err }
+
+	defer gobip39.Wipe(entropy)
+
+	return encodeBase85(entropy)[:length], nil
+}
+
+// Helper method to encode data with the RFC 1924 Base85 alphabet.
+// data must be a multiple of 4 bytes long, which the 64 bytes of
+// derived entropy always are.
+func encodeBase85(data []byte) string {
+	encoded := make([]byte, 0, len(data) / 4 * 5)
+
+	for i := 0; i + 4 <= len(data); i += 4 {
+		value := uint32(data[i]) << 24 | uint32(data[i + 1]) << 16 | uint32(data[i + 2]) << 8 | uint32(data[i + 3])
+
+		var block [5]byte
+		for j := 4; j >= 0; j-- {
+			block[j] = base85Alphabet[value % 85]
+			value /= 85
+		}
+
+		encoded = append(encoded, block[:]...)
+	}
+
+	return string(encoded)
+}
@@ -0,0 +1,65 @@
+package bip85
+
+// This file wraps deterministic entropy derivation as detailed by
+// BIP-0085 spec: https://github.com/bitcoin/bips/blob/master/bip-0085.mediawiki
+
+import (
+	"crypto/hmac"
+	SHA512 "crypto/sha512"
+	"gobip39/slip10"
+)
+
+const (
+	Purpose uint32 = 83696968
+	EntropyLength = 64
+	hmacKey = "bip-entropy-from-k"
+)
+
+// Application numbers, the first index below Purpose
+const (
+	BIP39Application uint32 = 39
+	WIFApplication uint32 = 2
+	XPRVApplication uint32 = 32
+	HexApplication uint32 = 128169
+	Base64PasswordApplication uint32 = 707764
+	Base85PasswordApplication uint32 = 707785
+)
+
+// Error type specifically for BIP-0085 errors
+type bip85Error struct {
+	Message string
+}
+
+func (err bip85Error) Error() string {
+	return err.Message
+}
+
+// Derive 64 bytes of entropy from a master key at m/83696968'/indices...,
+// with every index hardened. The entropy is HMAC-SHA512 keyed with
+// "bip-entropy-from-k" over the derived private key.
+// An error is returned if the master key is not a secp256k1 key, an index
+// is not below 2^31, or derivation fails.
+func DeriveEntropy(master slip10.Key, indices ...uint32) ([]byte, error) {
+	if (master.Curve != slip10.Secp256k1) {
+		return []byte{}, bip85Error{Message: "BIP-0085 requires a secp256k1 master key."}
+	}
+
+	key, err := master.Derive(Purpose + slip10.HardenedOffset)
+
+	if (err != nil) { return []byte{}, err }
+
+	for _, index := range indices {
+		if (index >= slip10.HardenedOffset) {
+			return []byte{}, bip85Error{Message: "Indices must be below 2^31."}
+		}
+
+		key, err = key.Derive(index + slip10.HardenedOffset)
+
+		if (err != nil) { return []byte{}, err }
+	}
+
+	mac := hmac.New(SHA512.New, []byte(hmacKey))
+	mac.Write(key.PrivateKey[:])
+
+	return mac.Sum(nil), nil
+}
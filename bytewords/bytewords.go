@@ -0,0 +1,157 @@
+package bytewords
+
+// This file implements Bytewords, which encode each byte as a four letter
+// word, as detailed by BCR-2020-012 spec:
+// https://github.com/BlockchainCommons/Research/blob/master/papers/bcr-2020-012-bytewords.md
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"strings"
+)
+
+const (
+	WordCount = 256
+	WordLength = 4
+	ChecksumLength = 4
+)
+
+// All 256 words, concatenated in byte order. Each word is uniquely
+// identified by its first and last letters.
+const words =
+	"ableacidalsoapexaquaarchatomauntawayaxisbackbaldbarnbeltbetabias" +
+	"bluebodybragbrewbulbbuzzcalmcashcatschefcityclawcodecolacookcost" +
+	"cruxcurlcuspcyandarkdatadaysdelidicedietdoordowndrawdropdrumdull" +
+	"dutyeacheasyechoedgeepicevenexamexiteyesfactfairfernfigsfilmfish" +
+	"fizzflapflewfluxfoxyfreefrogfuelfundgalagamegeargemsgiftgirlglow" +
+	"goodgraygrimgurugushgyrohalfhanghardhawkheathelphighhillholyhope" +
+	"hornhutsicedideaidleinchinkyintoirisironitemjadejazzjoinjoltjowl" +
+	"judojugsjumpjunkjurykeepkenokeptkeyskickkilnkingkitekiwiknoblamb" +
+	"lavalazyleaflegsliarlimplionlistlogoloudloveluaulucklungmainmany" +
+	"mathmazememomenumeowmildmintmissmonknailnavyneednewsnextnoonnote" +
+	"numbobeyoboeomitonyxopenovalowlspaidpartpeckplaypluspoempoolpose" +
+	"puffpumapurrquadquizraceramprealredorichroadrockroofrubyruinruns" +
+	"rustsafesagascarsetssilkskewslotsoapsolosongstubsurfswantacotask" +
+	"taxitenttiedtimetinytoiltombtoystriptunatwinuglyundouniturgeuser" +
+	"vastveryvetovialvibeviewvisavoidvowswallwandwarmwaspwavewaxywebs" +
+	"whatwhenwhizwolfworkyankyawnyellyogayurtzapszerozestzinczonezoom"
+
+// Encoding styles
+type Style int
+
+const (
+	// Words separated by spaces
+	Standard Style = iota
+	// Words separated by hyphens, for use in URIs
+	URI
+	// First and last letter of each word, as used by URs
+	Minimal
+)
+
+// Error type specifically for bytewords errors
+type bytewordsError struct {
+	Message string
+}
+
+func (err bytewordsError) Error() string {
+	return err.Message
+}
+
+// Index of each byte's word by its first and last letters.
+var minimalIndex = map[string]int{}
+
+func init() {
+	for i := 0; i < WordCount; i++ {
+		minimalIndex[words[i * WordLength:i * WordLength + 1] + words[i * WordLength + 3:i * WordLength + 4]] = i
+	}
+}
+
+// Get the word encoding a byte.
+func Word(b byte) string {
+	return words[int(b) * WordLength:int(b) * WordLength + WordLength]
+}
+
+// Encode data followed by its CRC32 checksum in a style.
+func Encode(data []byte, style Style) string {
+	withChecksum := binary.BigEndian.AppendUint32(data[:len(data):len(data)], crc32.ChecksumIEEE(data))
+
+	encoded := make([]string, len(withChecksum))
+
+	for i, b := range withChecksum {
+		word := Word(b)
+
+		if (style == Minimal) {
+			word = word[:1] + word[3:]
+		}
+
+		encoded[i] = word
+	}
+
+	switch style {
+	case Standard:
+		return strings.Join(encoded, " ")
+	case URI:
+		return strings.Join(encoded, "-")
+	}
+
+	return strings.Join(encoded, "")
+}
+
+// Decode data encoded in a style, verifying and removing its CRC32 checksum.
+// Decoding is case-insensitive.
+// An error is returned if a word is invalid, the data is too short to
+// hold a checksum, or the checksum does not match.
+func Decode(encoded string, style Style) ([]byte, error) {
+	encoded = strings.ToLower(encoded)
+
+	var tokens []string
+
+	switch style {
+	case Standard:
+		tokens = strings.Split(encoded, " ")
+	case URI:
+		tokens = strings.Split(encoded, "-")
+	default:
+		if (len(encoded) % 2 != 0) {
+			return []byte{}, bytewordsError{Message: "Minimal bytewords must have an even length."}
+		}
+
+		for i := 0; i < len(encoded); i += 2 {
+			tokens = append(tokens, encoded[i:i + 2])
+		}
+	}
+
+	data := make([]byte, len(tokens))
+
+	for i, token := range tokens {
+		key := token
+
+		if (style != Minimal) {
+			if (len(token) != WordLength) {
+				return []byte{}, bytewordsError{Message: "Invalid byteword '" + token + "'."}
+			}
+
+			key = token[:1] + token[3:]
+		}
+
+		index, ok := minimalIndex[key]
+
+		if (!ok || (style != Minimal && Word(byte(index)) != token)) {
+			return []byte{}, bytewordsError{Message: "Invalid byteword '" + token + "'."}
+		}
+
+		data[i] = byte(index)
+	}
+
+	if (len(data) < ChecksumLength) {
+		return []byte{}, bytewordsError{Message: "Bytewords are too short to hold a checksum."}
+	}
+
+	body := data[:len(data) - ChecksumLength]
+
+	if (crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(data[len(body):])) {
+		return []byte{}, bytewordsError{Message: "Bytewords checksum does not match."}
+	}
+
+	return body, nil
+}
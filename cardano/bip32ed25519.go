@@ -0,0 +1,197 @@
+package cardano
+
+// This file wraps BIP32-Ed25519 hierarchical derivation (version 2, as used
+// by Icarus wallets) as detailed by the BIP32-Ed25519 paper:
+// https://input-output-hk.github.io/adrestia/static/Ed25519_BIP.pdf
+
+import (
+	"crypto/hmac"
+	SHA512 "crypto/sha512"
+	"encoding/binary"
+	"filippo.io/edwards25519"
+)
+
+const (
+	HardenedOffset uint32 = 0x80000000
+)
+
+// Type to wrap an extended private key: the 64 byte extended
+// Ed25519 secret (kL || kR) and its chain code.
+type Key struct {
+	Depth byte
+	ChildNumber uint32
+	PrivateKey [64]byte
+	ChainCode [32]byte
+}
+
+// Type to wrap an extended public key: the Ed25519 point
+// and its chain code.
+type PublicKey struct {
+	Depth byte
+	ChildNumber uint32
+	Key [32]byte
+	ChainCode [32]byte
+}
+
+// Serialize the key as kL || kR || chain code, the 96 byte
+// form used by CIP-0003 test vectors.
+func (key Key) Bytes() []byte {
+	return append(key.PrivateKey[:], key.ChainCode[:]...)
+}
+
+// Serialize the public key as A || chain code.
+func (key PublicKey) Bytes() []byte {
+	return append(key.Key[:], key.ChainCode[:]...)
+}
+
+// Get the extended public key for this key, which is kL
+// multiplied by the Ed25519 base point.
+func (key Key) Public() PublicKey {
+	return PublicKey{Depth: key.Depth, ChildNumber: key.ChildNumber, Key: scalarBaseMult(key.PrivateKey[:32]), ChainCode: key.ChainCode}
+}
+
+// Derive the child key at index. Indices at or above HardenedOffset
+// are hardened.
+// An error is returned when the maximum depth has been reached.
+func (key Key) Derive(index uint32) (Key, error) {
+	if (key.Depth == 0xFF) {
+		return Key{}, cardanoError{Message: "Maximum derivation depth reached."}
+	}
+
+	var zTag, cTag byte
+	var material []byte
+
+	if (index >= HardenedOffset) {
+		zTag, cTag = 0x00, 0x01
+		material = key.PrivateKey[:]
+	} else {
+		publicKey := scalarBaseMult(key.PrivateKey[:32])
+		zTag, cTag = 0x02, 0x03
+		material = publicKey[:]
+	}
+
+	z := childHMAC(key.ChainCode[:], zTag, material, index)
+	c := childHMAC(key.ChainCode[:], cTag, material, index)
+
+	child := Key{Depth: key.Depth + 1, ChildNumber: index}
+
+	// kL' = 8 * zL + kL, where zL is the first 28 bytes of Z
+	addMultipliedBy8(child.PrivateKey[:32], key.PrivateKey[:32], z[:28])
+
+	// kR' = zR + kR (mod 2^256)
+	addLittleEndian(child.PrivateKey[32:], key.PrivateKey[32:], z[32:])
+
+	copy(child.ChainCode[:], c[32:])
+
+	return child, nil
+}
+
+// Derive the key at a sequence of indices relative to this key.
+// An error is returned if any derivation step fails.
+func (key Key) DerivePath(indices ...uint32) (Key, error) {
+	for _, index := range indices {
+		var err error
+		key, err = key.Derive(index)
+
+		if (err != nil) { return Key{}, err }
+	}
+
+	return key, nil
+}
+
+// Derive the non-hardened child public key at index.
+// An error is returned if index is hardened or the maximum
+// depth has been reached.
+func (key PublicKey) Derive(index uint32) (PublicKey, error) {
+	if (index >= HardenedOffset) {
+		return PublicKey{}, cardanoError{Message: "Cannot derive a hardened child from a public key."}
+	}
+
+	if (key.Depth == 0xFF) {
+		return PublicKey{}, cardanoError{Message: "Maximum derivation depth reached."}
+	}
+
+	z := childHMAC(key.ChainCode[:], 0x02, key.Key[:], index)
+	c := childHMAC(key.ChainCode[:], 0x03, key.Key[:], index)
+
+	// A' = A + (8 * zL) * B
+	var scalar [32]byte
+	addMultipliedBy8(scalar[:], make([]byte, 32), z[:28])
+	offset := scalarBaseMult(scalar[:])
+
+	parentPoint, err := new(edwards25519.Point).SetBytes(key.Key[:])
+
+	if (err != nil) { return PublicKey{}, cardanoError{Message: err.Error()} }
+
+	offsetPoint, _ := new(edwards25519.Point).SetBytes(offset[:])
+
+	child := PublicKey{Depth: key.Depth + 1, ChildNumber: index}
+	copy(child.Key[:], new(edwards25519.Point).Add(parentPoint, offsetPoint).Bytes())
+	copy(child.ChainCode[:], c[32:])
+
+	return child, nil
+}
+
+// Helper method to compute HMAC-SHA512(chainCode, tag || material || index),
+// where index is serialized little endian.
+func childHMAC(chainCode []byte, tag byte, material []byte, index uint32) []byte {
+	indexBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(indexBytes, index)
+
+	mac := hmac.New(SHA512.New, chainCode)
+	mac.Write([]byte{tag})
+	mac.Write(material)
+	mac.Write(indexBytes)
+
+	return mac.Sum(nil)
+}
+
+// Helper method to multiply the Ed25519 base point by a little
+// endian scalar. The scalar is reduced modulo the group order,
+// which leaves the resulting point unchanged.
+func scalarBaseMult(scalar []byte) [32]byte {
+	wide := make([]byte, 64)
+	copy(wide, scalar)
+
+	s, _ := edwards25519.NewScalar().SetUniformBytes(wide)
+
+	var point [32]byte
+	copy(point[:], new(edwards25519.Point).ScalarBaseMult(s).Bytes())
+
+	return point
+}
+
+// Helper method to set out = a + 8 * b for little endian integers,
+// truncated to the length of out.
+func addMultipliedBy8(out []byte, a []byte, b []byte) {
+	var carry uint16
+
+	for i := 0; i < len(out); i++ {
+		var bi uint16
+		if (i < len(b)) {
+			bi = uint16(b[i])
+		}
+
+		// Shift b left by 3 bits, pulling the top 3 bits of the previous byte in
+		var previous uint16
+		if (i > 0 && i - 1 < len(b)) {
+			previous = uint16(b[i - 1]) >> 5
+		}
+
+		sum := uint16(a[i]) + (bi << 3 & 0xFF | previous) + carry
+		out[i] = byte(sum)
+		carry = sum >> 8
+	}
+}
+
+// Helper method to set out = a + b for little endian integers,
+// truncated to the length of out.
+func addLittleEndian(out []byte, a []byte, b []byte) {
+	var carry uint16
+
+	for i := 0; i < len(out); i++ {
+		sum := uint16(a[i]) + uint16(b[i]) + carry
+		out[i] = byte(sum)
+		carry = sum >> 8
+	}
+}
@@ -0,0 +1,50 @@
+package cardano
+
+// This file wraps Shelley-era account and address key derivation as detailed by
+// CIP-1852 spec: https://cips.cardano.org/cips/cip1852/
+
+const (
+	Purpose uint32 = 1852
+	CoinType uint32 = 1815
+)
+
+// Roles (chains) of keys below an account, as detailed by CIP-1852.
+type Role uint32
+
+const (
+	ExternalChain Role = 0
+	InternalChain Role = 1
+	StakingKey Role = 2
+)
+
+// Derive the account key at m/1852'/1815'/account' from a master key.
+// An error is returned if account is not below HardenedOffset.
+func (key Key) DeriveAccountKey(account uint32) (Key, error) {
+	if (account >= HardenedOffset) {
+		return Key{}, cardanoError{Message: "Account index must be below 2^31."}
+	}
+
+	return key.DerivePath(Purpose + HardenedOffset, CoinType + HardenedOffset, account + HardenedOffset)
+}
+
+// Derive the address key at role/index from an account key.
+// An error is returned if index is not below HardenedOffset.
+func (key Key) DeriveAddressKey(role Role, index uint32) (Key, error) {
+	if (index >= HardenedOffset || uint32(role) >= HardenedOffset) {
+		return Key{}, cardanoError{Message: "Role and address index must be below 2^31."}
+	}
+
+	return key.DerivePath(uint32(role), index)
+}
+
+// Derive the public address key at role/index from an account's
+// public key, allowing addresses to be generated without the
+// account's private key.
+// An error is returned if index is not below HardenedOffset.
+func (key PublicKey) DeriveAddressKey(role Role, index uint32) (PublicKey, error) {
+	roleKey, err := key.Derive(uint32(role))
+
+	if (err != nil) { return PublicKey{}, err }
+
+	return roleKey.Derive(index)
+}
@@ -0,0 +1,62 @@
+package cardano
+
+// This file wraps Cardano's Icarus master key generation as detailed by
+// CIP-0003 spec: https://cips.cardano.org/cips/cip3/
+// Unlike BIP-0039 seeds, the master key is derived directly from the
+// mnemonic's Entropy.
+
+import (
+	"golang.org/x/crypto/pbkdf2"
+	SHA512 "crypto/sha512"
+	"gobip39"
+)
+
+const (
+	IcarusIterations = 4096
+	MasterKeyLength = 96
+)
+
+// Error type specifically for Cardano errors
+type cardanoError struct {
+	Message string
+}
+
+func (err cardanoError) Error() string {
+	return err.Message
+}
+
+// Generate the Icarus master key from Entropy and an optional passphrase.
+// The key is PBKDF2-HMAC-SHA512 over the passphrase, salted with the entropy,
+// with its private scalar clamped as required by BIP32-Ed25519.
+// An error is returned if the Entropy's size is invalid, in which
+// case the Key returned is in an invalid state.
+func NewIcarusMasterKey(ent gobip39.Entropy, passphrase ...string) (Key, error) {
+	if (ent.Size > gobip39.MaximumEntropySize || ent.Size < gobip39.MinimumEntropySize || ent.Size % 32 != 0) {
+		return Key{}, cardanoError{Message: "Size of entropy was invalid."}
+	}
+
+	if (len(ent.Data) * 8 != int(ent.Size)) {
+		return Key{}, cardanoError{Message: "Size of entropy does not match its data."}
+	}
+
+	// If there are any arguments passed, assume the first one is the passphrase.
+	_passphrase := ""
+	if (passphrase != nil) {
+		_passphrase = passphrase[0]
+	}
+
+	data := pbkdf2.Key([]byte(_passphrase), ent.Data, IcarusIterations, MasterKeyLength, SHA512.New)
+
+	defer gobip39.Wipe(data)
+
+	// Clear the lowest 3 bits and the highest 3 bits, then set the second highest bit
+	data[0] &= 0xF8
+	data[31] &= 0x1F
+	data[31] |= 0x40
+
+	var key Key
+	copy(key.PrivateKey[:], data[:64])
+	copy(key.ChainCode[:], data[64:])
+
+	return key, nil
+}
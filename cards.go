@@ -0,0 +1,125 @@
+package gobip39
+
+// This file builds Entropy from a shuffled deck of playing cards. The
+// order of the cards is numbered by its Lehmer code, which is hashed
+// into Entropy of the requested size.
+
+import (
+	"crypto/sha256"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	DeckSize = 52
+	// Order of the deck's ranks and suits; "10" is also accepted for "T"
+	cardRanks = "A23456789TJQK"
+	cardSuits = "SHDC"
+	// Bytes holding any Lehmer code of the deck, as 52! < 2^226
+	lehmerCodeSize = 29
+)
+
+// Bits of entropy in an ordering of the full deck, log2(52!) ≈ 225.58.
+var FullDeckEntropyBits = CardEntropyBits(DeckSize)
+
+// Get the bits of entropy in an ordering of count cards drawn from a
+// shuffled deck, log2(52! / (52 - count)!).
+func CardEntropyBits(count int) float64 {
+	bits := 0.0
+
+	for i := 0; i < count && i < DeckSize; i++ {
+		bits += math.Log2(float64(DeckSize - i))
+	}
+
+	return bits
+}
+
+// Generate Entropy of size bits from cards drawn from a shuffled deck,
+// given as rank and suit separated by whitespace (e.g. "AS 7H KD 10C").
+// A partial deck may be given when its cards hold size bits; as a full
+// deck only holds about 225.58 bits, 256-bit Entropy needs the full deck.
+// The Entropy is the SHA-256 digest of the cards' Lehmer code, truncated
+// to size bits, and the bits the cards supplied are returned alongside it.
+// An error is returned if size is invalid, a card is unknown or repeated,
+// or the cards supply too few bits.
+func GetEntropyFromCards(cards string, size uint16) (Entropy, float64, error) {
+	if err := checkEntropySize(size); err != nil {
+		return Entropy{}, 0, err
+	}
+
+	code, count, err := getLehmerCode(strings.Fields(cards))
+
+	if (err != nil) { return Entropy{}, 0, err }
+
+	supplied := CardEntropyBits(count)
+
+	if (supplied < math.Min(float64(size), FullDeckEntropyBits)) {
+		return Entropy{}, supplied, entropyError{Message: strconv.Itoa(count) + " cards supply fewer than " + strconv.Itoa(int(size)) + " bits of entropy."}
+	}
+
+	codeBytes := code.FillBytes(make([]byte, lehmerCodeSize))
+	digest := sha256.Sum256(codeBytes)
+	ent, err := GetEntropyFromBytes(append([]byte{}, digest[:size / 8]...))
+	Wipe(codeBytes)
+	Wipe(digest[:])
+
+	// Zero the words backing the code, which SetInt64(0) would keep
+	words := code.Bits()
+
+	for i := range words {
+		words[i] = 0
+	}
+
+	return ent, supplied, err
+}
+
+// Helper method that numbers an ordering of cards by its Lehmer code:
+// each card is the digit of how many unseen cards precede it in deck
+// order, the digits forming a number with radixes 52, 51, 50, ...
+// An error is returned if a card is unknown or repeated.
+func getLehmerCode(cards []string) (*big.Int, int, error) {
+	var seen [DeckSize]bool
+	code := new(big.Int)
+
+	for i, card := range cards {
+		index, err := getCardIndex(card)
+
+		if (err != nil) { return nil, 0, err }
+
+		if (seen[index]) {
+			return nil, 0, entropyError{Message: "Card '" + card + "' is repeated."}
+		}
+
+		seen[index] = true
+		digit := 0
+
+		for j := 0; j < index; j++ {
+			if (!seen[j]) { digit++ }
+		}
+
+		code.Mul(code, big.NewInt(int64(DeckSize - i)))
+		code.Add(code, big.NewInt(int64(digit)))
+	}
+
+	return code, len(cards), nil
+}
+
+// Helper method to get a card's position in a deck ordered by suit
+// (spades, hearts, diamonds, clubs), then rank (ace to king).
+// An error is returned if the card is not a rank followed by a suit.
+func getCardIndex(card string) (int, error) {
+	normalized := strings.Replace(strings.ToUpper(card), "10", "T", 1)
+
+	if (len(normalized) == 2) {
+		rank := strings.IndexByte(cardRanks, normalized[0])
+		suit := strings.IndexByte(cardSuits, normalized[1])
+
+		if (rank >= 0 && suit >= 0) {
+			return suit * len(cardRanks) + rank, nil
+		}
+	}
+
+	return 0, entropyError{Message: "'" + card + "' is not a playing card."}
+}
@@ -0,0 +1,246 @@
+package cbor
+
+// This file implements the subset of CBOR (RFC 8949) needed by Uniform
+// Resources: unsigned integers, byte and text strings, arrays, maps, tags
+// and simple values, always in the deterministic (shortest) encoding.
+
+import (
+	"encoding/binary"
+)
+
+// Major types
+const (
+	Unsigned byte = 0
+	Negative byte = 1
+	Bytes byte = 2
+	Text byte = 3
+	Array byte = 4
+	Map byte = 5
+	Tag byte = 6
+	Simple byte = 7
+)
+
+// Error type specifically for CBOR errors
+type cborError struct {
+	Message string
+}
+
+func (err cborError) Error() string {
+	return err.Message
+}
+
+// Append the head of an item with a major type and argument,
+// using the shortest encoding of the argument.
+func AppendHead(buffer []byte, major byte, argument uint64) []byte {
+	major <<= 5
+
+	switch {
+	case argument < 24:
+		return append(buffer, major | byte(argument))
+	case argument <= 0xFF:
+		return append(buffer, major | 24, byte(argument))
+	case argument <= 0xFFFF:
+		return binary.BigEndian.AppendUint16(append(buffer, major | 25), uint16(argument))
+	case argument <= 0xFFFFFFFF:
+		return binary.BigEndian.AppendUint32(append(buffer, major | 26), uint32(argument))
+	}
+
+	return binary.BigEndian.AppendUint64(append(buffer, major | 27), argument)
+}
+
+// Encode an unsigned integer.
+func EncodeUnsigned(value uint64) []byte {
+	return AppendHead(nil, Unsigned, value)
+}
+
+// Encode a byte string.
+func EncodeBytes(data []byte) []byte {
+	return append(AppendHead(nil, Bytes, uint64(len(data))), data...)
+}
+
+// Encode a UTF-8 text string.
+func EncodeText(text string) []byte {
+	return append(AppendHead(nil, Text, uint64(len(text))), text...)
+}
+
+// Encode a tag around an already encoded item.
+func EncodeTag(tag uint64, item []byte) []byte {
+	return append(AppendHead(nil, Tag, tag), item...)
+}
+
+// Encode an array of already encoded items.
+func EncodeArray(items ...[]byte) []byte {
+	encoded := AppendHead(nil, Array, uint64(len(items)))
+
+	for _, item := range items {
+		encoded = append(encoded, item...)
+	}
+
+	return encoded
+}
+
+// Encode a map from alternating already encoded keys and values.
+// Callers are responsible for ordering keys deterministically.
+func EncodeMap(keysAndValues ...[]byte) []byte {
+	encoded := AppendHead(nil, Map, uint64(len(keysAndValues) / 2))
+
+	for _, item := range keysAndValues {
+		encoded = append(encoded, item...)
+	}
+
+	return encoded
+}
+
+// Type to read CBOR items in order from encoded data.
+type Decoder struct {
+	data []byte
+	offset int
+}
+
+// Create a Decoder over encoded data.
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data}
+}
+
+// Whether all data has been read.
+func (decoder *Decoder) Done() bool {
+	return decoder.offset >= len(decoder.data)
+}
+
+// Major type of the next item without consuming it.
+// An error is returned if there is no next item.
+func (decoder *Decoder) PeekMajor() (byte, error) {
+	if (decoder.Done()) {
+		return 0, cborError{Message: "Unexpected end of CBOR data."}
+	}
+
+	return decoder.data[decoder.offset] >> 5, nil
+}
+
+// Read the head of the next item, returning its major type and argument.
+// An error is returned if the data ends early or the head uses an
+// indefinite length or reserved encoding.
+func (decoder *Decoder) ReadHead() (byte, uint64, error) {
+	if (decoder.Done()) {
+		return 0, 0, cborError{Message: "Unexpected end of CBOR data."}
+	}
+
+	initial := decoder.data[decoder.offset]
+	decoder.offset++
+
+	major, info := initial >> 5, initial & 0x1F
+
+	if (info < 24) {
+		return major, uint64(info), nil
+	}
+
+	if (info > 27) {
+		return 0, 0, cborError{Message: "Indefinite length and reserved CBOR items are not supported."}
+	}
+
+	// 24, 25, 26 and 27 are followed by 1, 2, 4 and 8 byte arguments
+	length := 1 << (info - 24)
+
+	if (decoder.offset + length > len(decoder.data)) {
+		return 0, 0, cborError{Message: "Unexpected end of CBOR data."}
+	}
+
+	var argument uint64
+	for _, b := range decoder.data[decoder.offset:decoder.offset + length] {
+		argument = argument << 8 | uint64(b)
+	}
+
+	decoder.offset += length
+
+	return major, argument, nil
+}
+
+// Read the head of the next item, checking its major type.
+func (decoder *Decoder) expect(major byte) (uint64, error) {
+	actual, argument, err := decoder.ReadHead()
+
+	if (err != nil) { return 0, err }
+
+	if (actual != major) {
+		return 0, cborError{Message: "Unexpected CBOR major type."}
+	}
+
+	return argument, nil
+}
+
+// Read an unsigned integer.
+func (decoder *Decoder) ReadUnsigned() (uint64, error) {
+	return decoder.expect(Unsigned)
+}
+
+// Read a byte string.
+func (decoder *Decoder) ReadBytes() ([]byte, error) {
+	length, err := decoder.expect(Bytes)
+
+	if (err != nil) { return []byte{}, err }
+
+	return decoder.readContent(length)
+}
+
+// Read a text string.
+func (decoder *Decoder) ReadText() (string, error) {
+	length, err := decoder.expect(Text)
+
+	if (err != nil) { return "", err }
+
+	content, contentErr := decoder.readContent(length)
+
+	return string(content), contentErr
+}
+
+// Read a tag, returning its number. The tagged item follows.
+func (decoder *Decoder) ReadTag() (uint64, error) {
+	return decoder.expect(Tag)
+}
+
+// Read an array's head, returning its number of items.
+func (decoder *Decoder) ReadArrayHead() (uint64, error) {
+	return decoder.expect(Array)
+}
+
+// Read a map's head, returning its number of key/value pairs.
+func (decoder *Decoder) ReadMapHead() (uint64, error) {
+	return decoder.expect(Map)
+}
+
+// Skip the next item, including everything nested within it.
+// An error is returned if the item is malformed.
+func (decoder *Decoder) Skip() error {
+	major, argument, err := decoder.ReadHead()
+
+	if (err != nil) { return err }
+
+	switch major {
+	case Bytes, Text:
+		_, err = decoder.readContent(argument)
+	case Array:
+		for i := uint64(0); i < argument && err == nil; i++ {
+			err = decoder.Skip()
+		}
+	case Map:
+		for i := uint64(0); i < argument * 2 && err == nil; i++ {
+			err = decoder.Skip()
+		}
+	case Tag:
+		err = decoder.Skip()
+	}
+
+	return err
+}
+
+// Helper method to read length bytes of string content.
+func (decoder *Decoder) readContent(length uint64) ([]byte, error) {
+	if (length > uint64(len(decoder.data) - decoder.offset)) {
+		return []byte{}, cborError{Message: "Unexpected end of CBOR data."}
+	}
+
+	content := decoder.data[decoder.offset:decoder.offset + int(length)]
+	decoder.offset += int(length)
+
+	return content, nil
+}
@@ -0,0 +1,89 @@
+package codex32
+
+// This file implements the codex32 BCH checksums as detailed by
+// BIP-0093 spec: https://github.com/bitcoin/bips/blob/master/bip-0093.mediawiki
+
+import (
+	"math/big"
+)
+
+// A BCH code over GF(32), with residues held as big-endian symbols
+type bchCode struct {
+	generator [5][]byte
+	initial []byte
+	target []byte
+}
+
+var (
+	// 13 symbol checksum of codex32 strings up to 93 data characters
+	shortCode = newBCHCode(13, [5]string{"19dc500ce73fde210", "1bfae00def77fe529", "1fbd920fffe7bee52", "1739640bdeee3fdad", "07729a039cfc75f5a"}, "10ce0795c2fd1e62a")
+	// 15 symbol checksum of long codex32 strings
+	longCode = newBCHCode(15, [5]string{"3d59d273535ea62d897", "7a9becb6361c6c51507", "543f9b7e6c38d8a2a0e", "0c577eaeccf1990d13c", "1887f74f8dc71b10651"}, "43381e570bf4798ab26")
+)
+
+func newBCHCode(length int, generator [5]string, target string) bchCode {
+	code := bchCode{initial: symbols("23181b3", length), target: symbols(target, length)}
+
+	for i, hex := range generator {
+		code.generator[i] = symbols(hex, length)
+	}
+
+	return code
+}
+
+// Helper method that splits a hex constant into length 5-bit symbols.
+func symbols(hex string, length int) []byte {
+	value, _ := new(big.Int).SetString(hex, 16)
+	result := make([]byte, length)
+
+	for i := length - 1; i >= 0; i-- {
+		result[i] = byte(new(big.Int).And(value, big.NewInt(31)).Int64())
+		value.Rsh(value, 5)
+	}
+
+	return result
+}
+
+// Residue of the values divided by the code's generator polynomial.
+func (code bchCode) polymod(values []byte) []byte {
+	residue := append([]byte{}, code.initial...)
+
+	for _, value := range values {
+		top := residue[0]
+		residue = append(residue[1:], value)
+
+		for i, generator := range code.generator {
+			if (top >> uint(i) & 1 == 1) {
+				for j := range residue {
+					residue[j] ^= generator[j]
+				}
+			}
+		}
+	}
+
+	return residue
+}
+
+// Whether the values end with a valid checksum.
+func (code bchCode) verify(values []byte) bool {
+	residue := code.polymod(values)
+
+	for i := range residue {
+		if (residue[i] != code.target[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Checksum symbols to append to the values.
+func (code bchCode) create(values []byte) []byte {
+	residue := code.polymod(append(append([]byte{}, values...), make([]byte, len(code.target))...))
+
+	for i := range residue {
+		residue[i] ^= code.target[i]
+	}
+
+	return residue
+}
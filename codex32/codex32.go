@@ -0,0 +1,369 @@
+package codex32
+
+// This file implements codex32 strings and their threshold sharing as detailed by
+// BIP-0093 spec: https://github.com/bitcoin/bips/blob/master/bip-0093.mediawiki
+
+import (
+	"crypto/rand"
+	"strconv"
+	"strings"
+	"gobip39"
+	"gobip39/bech32"
+)
+
+const (
+	HRP = "ms"
+	Separator = "1"
+	IdentifierLength = 4
+	MinimumSeedLength = 16
+	MaximumSeedLength = 64
+	MinimumThreshold = 2
+	MaximumThreshold = 9
+	// Index of the secret share
+	SecretIndex = 's'
+	// Share indices in the order shares are generated; every Bech32
+	// character except the secret's
+	ShareIndices = "acdefghjklmnpqrtuvwxyz023456789"
+	// Longest data part, without checksum, that takes the short checksum
+	maximumShortData = 80
+	maximumShortLength = 93
+	minimumLongLength = 96
+	maximumLongLength = 124
+)
+
+// Error type specifically for codex32 errors
+type codex32Error struct {
+	Message string
+}
+
+func (err codex32Error) Error() string {
+	return err.Message
+}
+
+// Type to wrap a codex32 share. A Threshold of 0 marks an unshared
+// secret, which always has the SecretIndex.
+type Share struct {
+	Threshold int
+	Identifier string
+	Index byte
+	// 5-bit symbols of the payload
+	payload []byte
+}
+
+// The share's payload bytes, dropping up to 4 padding bits.
+func (share Share) Payload() []byte {
+	data, _ := bech32.ConvertBits(share.payload, 5, 8, true)
+
+	return data[:len(share.payload) * 5 / 8]
+}
+
+// Encode the share as a lowercase codex32 string, "ms1" followed by the
+// threshold, identifier, index, payload and checksum.
+// An error is returned if any of the share's fields is invalid.
+func (share Share) String() (string, error) {
+	values, err := share.values()
+
+	if (err != nil) { return "", err }
+
+	encoded := []byte(HRP + Separator)
+	for _, value := range append(values, checksumCode(len(values)).create(values)...) {
+		encoded = append(encoded, bech32.Charset[value])
+	}
+
+	return string(encoded), nil
+}
+
+// Helper method that builds the share's data part symbols without checksum.
+// An error is returned if a field is invalid.
+func (share Share) values() ([]byte, error) {
+	if (share.Threshold != 0 && (share.Threshold < MinimumThreshold || share.Threshold > MaximumThreshold)) {
+		return []byte{}, codex32Error{Message: "Threshold must be 0 or within domain [2, 9]."}
+	}
+
+	if (share.Threshold == 0 && share.Index != SecretIndex) {
+		return []byte{}, codex32Error{Message: "Unshared secrets must have the secret index 's'."}
+	}
+
+	if (len(share.Identifier) != IdentifierLength) {
+		return []byte{}, codex32Error{Message: "Identifier must be 4 Bech32 characters."}
+	}
+
+	header := strconv.Itoa(share.Threshold) + strings.ToLower(share.Identifier) + string(share.Index)
+	values := make([]byte, 0, len(header) + len(share.payload))
+
+	for i := 0; i < len(header); i++ {
+		value := strings.IndexByte(bech32.Charset, header[i])
+
+		if (value < 0) {
+			return []byte{}, codex32Error{Message: "Character '" + header[i:i + 1] + "' is not a Bech32 character."}
+		}
+
+		values = append(values, byte(value))
+	}
+
+	if (len(share.payload) == 0) {
+		return []byte{}, codex32Error{Message: "Share has no payload."}
+	}
+
+	return append(values, share.payload...), nil
+}
+
+// Parse a codex32 string in either case, but not mixed case.
+// An error is returned if the string is malformed, its checksum is
+// invalid, or its payload is not 16 to 64 bytes with at most 4 bits
+// of padding.
+func Parse(encoded string) (Share, error) {
+	if (strings.ToLower(encoded) != encoded && strings.ToUpper(encoded) != encoded) {
+		return Share{}, codex32Error{Message: "Codex32 strings must not mix cases."}
+	}
+
+	encoded = strings.ToLower(encoded)
+
+	if (!strings.HasPrefix(encoded, HRP + Separator)) {
+		return Share{}, codex32Error{Message: "Codex32 strings must begin with 'ms1'."}
+	}
+
+	data := encoded[len(HRP + Separator):]
+	values := make([]byte, len(data))
+
+	for i := 0; i < len(data); i++ {
+		value := strings.IndexByte(bech32.Charset, data[i])
+
+		if (value < 0) {
+			return Share{}, codex32Error{Message: "Character '" + data[i:i + 1] + "' is not a Bech32 character."}
+		}
+
+		values[i] = byte(value)
+	}
+
+	var code bchCode
+
+	switch {
+	case len(values) <= maximumShortLength:
+		code = shortCode
+	case len(values) >= minimumLongLength && len(values) <= maximumLongLength:
+		code = longCode
+	default:
+		return Share{}, codex32Error{Message: "Invalid codex32 string length."}
+	}
+
+	// Header of 6 symbols, payload, then checksum
+	payloadLength := len(values) - 6 - len(code.target)
+
+	if (payloadLength < 1) {
+		return Share{}, codex32Error{Message: "Invalid codex32 string length."}
+	}
+
+	if (!code.verify(values)) {
+		return Share{}, codex32Error{Message: "Codex32 checksum is invalid."}
+	}
+
+	if (payloadLength * 5 % 8 > 4 || payloadLength * 5 / 8 < MinimumSeedLength || payloadLength * 5 / 8 > MaximumSeedLength) {
+		return Share{}, codex32Error{Message: "Codex32 payload must be 16 to 64 bytes."}
+	}
+
+	threshold := 0
+	if (data[0] != '0') {
+		parsed, err := strconv.Atoi(data[:1])
+
+		if (err != nil || parsed < MinimumThreshold) {
+			return Share{}, codex32Error{Message: "Threshold must be 0 or within domain [2, 9]."}
+		}
+
+		threshold = parsed
+	}
+
+	share := Share{Threshold: threshold, Identifier: data[1:5], Index: data[5], payload: values[6:6 + payloadLength]}
+
+	if (threshold == 0 && share.Index != SecretIndex) {
+		return Share{}, codex32Error{Message: "Unshared secrets must have the secret index 's'."}
+	}
+
+	return share, nil
+}
+
+// Create the unshared codex32 secret of a master seed.
+// An error is returned if the seed is not 16 to 64 bytes or the
+// identifier is not 4 Bech32 characters.
+func NewSecret(seed []byte, identifier string) (Share, error) {
+	return newShare(seed, 0, identifier, SecretIndex)
+}
+
+// Helper method that builds a share from payload bytes, zero padded.
+func newShare(data []byte, threshold int, identifier string, index byte) (Share, error) {
+	if (len(data) < MinimumSeedLength || len(data) > MaximumSeedLength) {
+		return Share{}, codex32Error{Message: "Seed must be 16 to 64 bytes."}
+	}
+
+	payload, _ := bech32.ConvertBits(data, 8, 5, true)
+	share := Share{Threshold: threshold, Identifier: strings.ToLower(identifier), Index: index, payload: payload}
+
+	if _, err := share.values(); err != nil {
+		return Share{}, err
+	}
+
+	return share, nil
+}
+
+// Split a master seed into count shares, any threshold of which recover
+// it. The first threshold - 1 shares are random and the rest are
+// interpolated from them and the secret, in the order of ShareIndices.
+// An error is returned if threshold is outside [2, 9], count is outside
+// [threshold, 31], the seed or identifier is invalid, or the system's
+// randomness source fails.
+func Split(seed []byte, threshold int, count int, identifier string) ([]Share, error) {
+	if (threshold < MinimumThreshold || threshold > MaximumThreshold) {
+		return []Share{}, codex32Error{Message: "Threshold must be within domain [2, 9]."}
+	}
+
+	if (count < threshold || count > len(ShareIndices)) {
+		return []Share{}, codex32Error{Message: "Share count must be within domain [threshold, 31]."}
+	}
+
+	secret, err := newShare(seed, threshold, identifier, SecretIndex)
+
+	if (err != nil) { return []Share{}, err }
+
+	shares := make([]Share, count)
+	base := []Share{secret}
+
+	for i := 0; i < threshold - 1; i++ {
+		random := make([]byte, len(seed))
+
+		if _, err := rand.Read(random); err != nil {
+			return []Share{}, codex32Error{Message: err.Error()}
+		}
+
+		shares[i], _ = newShare(random, threshold, identifier, ShareIndices[i])
+		base = append(base, shares[i])
+	}
+
+	for i := threshold - 1; i < count; i++ {
+		shares[i], err = interpolateShares(base, ShareIndices[i])
+
+		if (err != nil) { return []Share{}, err }
+	}
+
+	return shares, nil
+}
+
+// Combine shares into the secret share. Shares beyond the threshold must
+// agree with those before them.
+// An error is returned if there are fewer shares than the threshold, the
+// shares disagree on threshold, identifier or length, two shares have the
+// same index, or an extra share is inconsistent.
+func Combine(shares []Share) (Share, error) {
+	if (len(shares) == 0) {
+		return Share{}, codex32Error{Message: "No shares to combine."}
+	}
+
+	first := shares[0]
+	seen := map[byte]bool{}
+
+	for _, share := range shares {
+		if (share.Threshold != first.Threshold || share.Identifier != first.Identifier || len(share.payload) != len(first.payload)) {
+			return Share{}, codex32Error{Message: "Shares do not belong to the same secret."}
+		}
+
+		if (seen[share.Index]) {
+			return Share{}, codex32Error{Message: "Share index '" + string(share.Index) + "' was given twice."}
+		}
+
+		seen[share.Index] = true
+	}
+
+	threshold := first.Threshold
+	if (threshold == 0) {
+		threshold = 1
+	}
+
+	if (len(shares) < threshold) {
+		return Share{}, codex32Error{Message: "Need " + strconv.Itoa(threshold) + " shares, got " + strconv.Itoa(len(shares)) + "."}
+	}
+
+	secret, err := interpolateShares(shares[:threshold], SecretIndex)
+
+	if (err != nil) { return Share{}, err }
+
+	for _, extra := range shares[threshold:] {
+		expected, _ := interpolateShares(shares[:threshold], extra.Index)
+
+		if (string(expected.payload) != string(extra.payload)) {
+			return Share{}, codex32Error{Message: "Share '" + string(extra.Index) + "' is inconsistent with the others."}
+		}
+	}
+
+	return secret, nil
+}
+
+// Helper method that interpolates the share at an index from shares with
+// distinct indices. The checksum is linear, so interpolating the data
+// part symbols yields a valid share.
+func interpolateShares(shares []Share, index byte) (Share, error) {
+	for _, share := range shares {
+		if (share.Index == index) {
+			return share, nil
+		}
+	}
+
+	values := make([][]byte, len(shares))
+	indices := make([]byte, len(shares))
+
+	for i, share := range shares {
+		var err error
+		values[i], err = share.values()
+
+		if (err != nil) { return Share{}, err }
+
+		indices[i] = values[i][5]
+	}
+
+	x := byte(strings.IndexByte(bech32.Charset, index))
+	result := interpolate(values, indices, x)
+
+	return Share{Threshold: shares[0].Threshold, Identifier: shares[0].Identifier, Index: index, payload: result[6:]}, nil
+}
+
+// Helper method that picks the checksum for a data part length.
+func checksumCode(length int) bchCode {
+	if (length > maximumShortData) {
+		return longCode
+	}
+
+	return shortCode
+}
+
+// Create the unshared codex32 secret of Entropy.
+// An error is returned if the Entropy or identifier is invalid.
+func NewSecretFromEntropy(ent gobip39.Entropy, identifier string) (Share, error) {
+	if _, err := gobip39.GetEntropyFromBytes(ent.Data); err != nil {
+		return Share{}, codex32Error{Message: err.Error()}
+	}
+
+	return NewSecret(ent.Data, identifier)
+}
+
+// Split Entropy into codex32 shares as Split does.
+// An error is returned if the Entropy is invalid or Split fails.
+func SplitEntropy(ent gobip39.Entropy, threshold int, count int, identifier string) ([]Share, error) {
+	if _, err := gobip39.GetEntropyFromBytes(ent.Data); err != nil {
+		return []Share{}, codex32Error{Message: err.Error()}
+	}
+
+	return Split(ent.Data, threshold, count, identifier)
+}
+
+// Combine shares and read the secret as BIP-0039 Entropy.
+// An error is returned if Combine fails or the secret is not a valid
+// Entropy size.
+func RecoverEntropy(shares []Share) (gobip39.Entropy, error) {
+	secret, err := Combine(shares)
+
+	if (err != nil) { return gobip39.Entropy{}, err }
+
+	ent, entErr := gobip39.GetEntropyFromBytes(secret.Payload())
+
+	if (entErr != nil) { return gobip39.Entropy{}, codex32Error{Message: entErr.Error()} }
+
+	return ent, nil
+}
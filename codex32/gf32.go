@@ -0,0 +1,61 @@
+package codex32
+
+// This file implements arithmetic and Lagrange interpolation over GF(32)
+// with the Bech32 polynomial x^5 + x^3 + 1.
+
+var gfExp [31]byte
+var gfLog [32]byte
+
+// Build logarithm tables using x as the generator.
+func init() {
+	value := byte(1)
+
+	for i := 0; i < 31; i++ {
+		gfExp[i] = value
+		gfLog[value] = byte(i)
+
+		value <<= 1
+		if (value & 0x20 != 0) {
+			value ^= 0x29
+		}
+	}
+}
+
+func multiply(a byte, b byte) byte {
+	if (a == 0 || b == 0) {
+		return 0
+	}
+
+	return gfExp[(int(gfLog[a]) + int(gfLog[b])) % 31]
+}
+
+// b must not be 0.
+func divide(a byte, b byte) byte {
+	if (a == 0) {
+		return 0
+	}
+
+	return gfExp[(int(gfLog[a]) + 31 - int(gfLog[b])) % 31]
+}
+
+// Evaluate at x, symbol by symbol, the polynomials of least degree that
+// pass through each share's data at its index. Subtraction is XOR.
+func interpolate(shares [][]byte, indices []byte, x byte) []byte {
+	result := make([]byte, len(shares[0]))
+
+	for i, share := range shares {
+		weight := byte(1)
+
+		for j := range shares {
+			if (i != j) {
+				weight = multiply(weight, divide(x ^ indices[j], indices[i] ^ indices[j]))
+			}
+		}
+
+		for k := range result {
+			result[k] ^= multiply(weight, share[k])
+		}
+	}
+
+	return result
+}
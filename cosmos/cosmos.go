@@ -0,0 +1,77 @@
+package cosmos
+
+// This file derives Cosmos SDK accounts at m/44'/118'/0'/0/index
+// and encodes their addresses in Bech32.
+
+import (
+	SHA256 "crypto/sha256"
+	"strconv"
+	"golang.org/x/crypto/ripemd160"
+	"gobip39/bech32"
+	"gobip39/slip10"
+)
+
+const (
+	CoinType uint32 = 118
+)
+
+// Human-readable prefixes of common Cosmos SDK chains
+const (
+	CosmosPrefix = "cosmos"
+	OsmosisPrefix = "osmo"
+	JunoPrefix = "juno"
+	AkashPrefix = "akash"
+	SecretPrefix = "secret"
+	CelestiaPrefix = "celestia"
+)
+
+// Error type specifically for Cosmos errors
+type cosmosError struct {
+	Message string
+}
+
+func (err cosmosError) Error() string {
+	return err.Message
+}
+
+// Derive the secp256k1 key at m/44'/118'/0'/0/index from a binary seed,
+// such as the output of GenerateBinarySeed.
+// An error is returned if the seed is invalid or index is not below 2^31.
+func DeriveKey(seed []byte, index uint32) (slip10.Key, error) {
+	if (index >= slip10.HardenedOffset) {
+		return slip10.Key{}, cosmosError{Message: "Address index must be below 2^31."}
+	}
+
+	master, err := slip10.NewMasterKey(seed, slip10.Secp256k1)
+
+	if (err != nil) { return slip10.Key{}, err }
+
+	return master.DerivePath("m/44'/118'/0'/0/" + strconv.FormatUint(uint64(index), 10))
+}
+
+// Account address bytes of a key: RIPEMD160(SHA256(compressed public key)).
+// An error is returned if the key is not a secp256k1 key.
+func AccountAddress(key slip10.Key) ([]byte, error) {
+	if (key.Curve != slip10.Secp256k1) {
+		return []byte{}, cosmosError{Message: "Cosmos accounts require a secp256k1 key."}
+	}
+
+	shaDigest := SHA256.Sum256(key.PublicKey())
+
+	ripemd := ripemd160.New()
+	ripemd.Write(shaDigest[:])
+
+	return ripemd.Sum(nil), nil
+}
+
+// Bech32 account address of a key under the human-readable prefix,
+// such as CosmosPrefix or OsmosisPrefix.
+// An error is returned if the key is not a secp256k1 key or the
+// prefix is invalid.
+func Address(key slip10.Key, prefix string) (string, error) {
+	account, err := AccountAddress(key)
+
+	if (err != nil) { return "", err }
+
+	return bech32.Encode(prefix, account)
+}
@@ -0,0 +1,42 @@
+package gobip39
+
+// This file provides a deterministic randomness source so tests can
+// generate reproducible Entropy and Mnemonics. It must never be used to
+// generate real wallets.
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// Reader whose stream is SHA-256(seed || counter) for counters 0, 1, ...
+// (8 bytes, big-endian), so the same seed always yields the same bytes.
+type DeterministicReader struct {
+	seed []byte
+	counter uint64
+	buffer []byte
+}
+
+// Create a DeterministicReader from a seed, e.g. a test's name.
+func NewDeterministicReader(seed []byte) *DeterministicReader {
+	return &DeterministicReader{seed: append([]byte{}, seed...)}
+}
+
+// Fill data with the next bytes of the stream. It never fails.
+func (reader *DeterministicReader) Read(data []byte) (int, error) {
+	for read := 0; read < len(data); {
+		if (len(reader.buffer) == 0) {
+			var counter [8]byte
+			binary.BigEndian.PutUint64(counter[:], reader.counter)
+			block := sha256.Sum256(append(append([]byte{}, reader.seed...), counter[:]...))
+			reader.buffer = block[:]
+			reader.counter++
+		}
+
+		copied := copy(data[read:], reader.buffer)
+		reader.buffer = reader.buffer[copied:]
+		read += copied
+	}
+
+	return len(data), nil
+}
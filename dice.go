@@ -0,0 +1,111 @@
+package gobip39
+
+// This file builds Entropy from physical sources of randomness: rolls of
+// a six-sided die and flips of a coin. Only unbiased bits are kept, so
+// the Entropy is uniform as long as the die or coin is fair.
+
+import (
+	"math"
+	"strconv"
+	"unicode"
+)
+
+// Get the fewest d6 rolls that can hold size bits of entropy, each roll
+// holding log2(6) ≈ 2.58 bits. GetEntropyFromDiceRolls rejects fewer
+// rolls, though its unbiased conversion usually needs more (1.67 bits
+// per roll on average).
+func MinimumDiceRolls(size uint16) int {
+	return int(math.Ceil(float64(size) / math.Log2(6)))
+}
+
+// Generate Entropy of size bits from a string of d6 rolls ("1" to "6",
+// whitespace is ignored). Rolls are converted without bias: 1 to 4 give
+// the 2 bits 00 to 11, while 5 and 6 give the single bit 0 or 1. The
+// first size bits form the Entropy; the number of bits the rolls supplied
+// is returned alongside it.
+// An error is returned if size is invalid, a character is not a roll,
+// there are fewer than MinimumDiceRolls(size) rolls, or the rolls supply
+// fewer than size bits.
+func GetEntropyFromDiceRolls(rolls string, size uint16) (Entropy, uint, error) {
+	if err := checkEntropySize(size); err != nil {
+		return Entropy{}, 0, err
+	}
+
+	bits := []byte{}
+	count := 0
+
+	for _, roll := range rolls {
+		if (unicode.IsSpace(roll)) {
+			continue
+		}
+
+		if (roll < '1' || roll > '6') {
+			return Entropy{}, 0, entropyError{Message: "Character '" + string(roll) + "' is not a d6 roll."}
+		}
+
+		value := byte(roll - '1')
+		count++
+
+		if (value < 4) {
+			bits = append(bits, value >> 1, value & 1)
+		} else {
+			bits = append(bits, value & 1)
+		}
+	}
+
+	if (count < MinimumDiceRolls(size)) {
+		return Entropy{}, uint(len(bits)), entropyError{Message: strconv.Itoa(MinimumDiceRolls(size)) + " d6 rolls are needed for " + strconv.Itoa(int(size)) + " bits of entropy."}
+	}
+
+	return getEntropyFromBits(bits, size)
+}
+
+// Generate Entropy of size bits from a string of coin flips, as "H"/"T"
+// (heads is 1) or binary "1"/"0"; whitespace is ignored. The first size
+// flips form the Entropy; the number of flips is returned alongside it.
+// An error is returned if size is invalid, a character is not a flip,
+// or there are fewer than size flips.
+func GetEntropyFromCoinFlips(flips string, size uint16) (Entropy, uint, error) {
+	if err := checkEntropySize(size); err != nil {
+		return Entropy{}, 0, err
+	}
+
+	bits := []byte{}
+
+	for _, flip := range flips {
+		switch {
+		case unicode.IsSpace(flip):
+		case flip == '1' || flip == 'H' || flip == 'h':
+			bits = append(bits, 1)
+		case flip == '0' || flip == 'T' || flip == 't':
+			bits = append(bits, 0)
+		default:
+			return Entropy{}, 0, entropyError{Message: "Character '" + string(flip) + "' is not a coin flip."}
+		}
+	}
+
+	return getEntropyFromBits(bits, size)
+}
+
+// Helper method that packs the first size of the bits (one per byte)
+// into Entropy, most significant first, and also returns the number
+// of bits given.
+// An error is returned if fewer than size bits are given.
+func getEntropyFromBits(bits []byte, size uint16) (Entropy, uint, error) {
+	if (len(bits) < int(size)) {
+		return Entropy{}, uint(len(bits)), entropyError{Message: "Only " + strconv.Itoa(len(bits)) + " of the " + strconv.Itoa(int(size)) + " bits of entropy were supplied."}
+	}
+
+	data := make([]byte, size / 8)
+
+	for i := 0; i < int(size); i++ {
+		data[i / 8] |= bits[i] << uint(7 - i % 8)
+	}
+
+	supplied := uint(len(bits))
+	Wipe(bits)
+
+	ent, err := GetEntropyFromBytes(data)
+
+	return ent, supplied, err
+}
@@ -0,0 +1,227 @@
+package electrum
+
+// This file implements Electrum's native seed format as detailed by
+// Electrum seed version system: https://electrum.readthedocs.io/en/latest/seedphrase.html
+// Electrum seeds draw from the BIP-0039 English wordlist but carry a
+// version in the HMAC of the sentence instead of a checksum, and use their
+// own PBKDF2 salt, so they must never be read as BIP-0039 sentences.
+//
+// Seeds of the old (pre-2.0) Electrum format use a different wordlist
+// and are not handled here.
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"unicode"
+	SHA512 "crypto/sha512"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+	"gobip39"
+	"gobip39/wordlist"
+)
+
+const (
+	Pbkdf2Iterations = 2048
+	SaltPrefix = "electrum"
+	VersionKey = "Seed version"
+	// Bits of a generated seed, a whole number of 11-bit words
+	SeedBits = 132
+)
+
+// Type of an Electrum seed, as named by Electrum
+type SeedType string
+
+const (
+	Standard SeedType = "standard"
+	Segwit SeedType = "segwit"
+	TwoFactor SeedType = "2fa"
+	TwoFactorSegwit SeedType = "2fa_segwit"
+)
+
+// Hex prefix of the version HMAC of each SeedType, in detection order
+var seedTypes = []SeedType{Standard, Segwit, TwoFactor, TwoFactorSegwit}
+var versionPrefixes = map[SeedType]string{
+	Standard: "01",
+	Segwit: "100",
+	TwoFactor: "101",
+	TwoFactorSegwit: "102",
+}
+
+// Error type specifically for Electrum errors
+type electrumError struct {
+	Message string
+}
+
+func (err electrumError) Error() string {
+	return err.Message
+}
+
+// Error returned when a sentence read as BIP-0039 is an Electrum seed.
+// Deriving it as BIP-0039 would open a different, empty wallet.
+type SeedError struct {
+	Type SeedType
+}
+
+func (err SeedError) Error() string {
+	return "Sentence is an Electrum " + string(err.Type) + " seed, not a BIP-0039 mnemonic."
+}
+
+// Error returned when a sentence is both a valid BIP-0039 mnemonic and an
+// Electrum seed, which happens by chance for about 0.46% of BIP-0039
+// sentences. It carries both readings, so the user can be asked which
+// format they mean.
+type AmbiguousSeedError struct {
+	Type SeedType
+	Mnemonic gobip39.Mnemonic
+}
+
+func (err AmbiguousSeedError) Error() string {
+	return "Sentence is both a BIP-0039 mnemonic and an Electrum " + string(err.Type) + " seed."
+}
+
+// Normalize a sentence or passphrase as Electrum does: NFKD, lowercase,
+// no combining marks, single spaces, and no spaces between CJK characters.
+func Normalize(text string) string {
+	var stripped []rune
+
+	for _, r := range strings.ToLower(norm.NFKD.String(text)) {
+		if (!unicode.Is(unicode.Mn, r)) {
+			stripped = append(stripped, r)
+		}
+	}
+
+	words := []rune(strings.Join(strings.Fields(string(stripped)), " "))
+	normalized := make([]rune, 0, len(words))
+
+	for i, r := range words {
+		if (r == ' ' && isCJK(words[i - 1]) && isCJK(words[i + 1])) {
+			continue
+		}
+
+		normalized = append(normalized, r)
+	}
+
+	return string(normalized)
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Bopomofo)
+}
+
+// Get the type of an Electrum seed. Like Electrum, a TwoFactor version
+// only counts for sentences of 12 words or of at least 20 words.
+// An error is returned if the sentence's version matches no SeedType.
+func GetSeedType(sentence string) (SeedType, error) {
+	mac := hmac.New(SHA512.New, []byte(VersionKey))
+	mac.Write([]byte(Normalize(sentence)))
+	version := hex.EncodeToString(mac.Sum(nil))
+	wordCount := len(strings.Fields(sentence))
+
+	for _, seedType := range seedTypes {
+		if (seedType == TwoFactor && wordCount != 12 && wordCount < 20) {
+			continue
+		}
+
+		if (strings.HasPrefix(version, versionPrefixes[seedType])) {
+			return seedType, nil
+		}
+	}
+
+	return "", electrumError{Message: "Sentence is not an Electrum seed."}
+}
+
+// Whether a sentence is an Electrum seed of any SeedType.
+func IsSeed(sentence string) bool {
+	_, err := GetSeedType(sentence)
+
+	return err == nil
+}
+
+// Read a sentence as a BIP-0039 Mnemonic, refusing Electrum seeds.
+// A SeedError is returned if the sentence is an Electrum seed and not a
+// valid BIP-0039 sentence. A sentence passing both checks is returned
+// along with an AmbiguousSeedError holding the same Mnemonic, so the
+// caller can let the user choose. Another error is returned if the
+// sentence is neither.
+func GetBIP39Mnemonic(sentence string, wl wordlist.Wordlist) (gobip39.Mnemonic, error) {
+	mnemonic, err := gobip39.GetMnemonicFromSentence(strings.Fields(sentence), wl)
+	seedType, seedErr := GetSeedType(sentence)
+
+	if (seedErr != nil) { return mnemonic, err }
+
+	if (err != nil) {
+		return gobip39.Mnemonic{}, SeedError{Type: seedType}
+	}
+
+	return mnemonic, AmbiguousSeedError{Type: seedType, Mnemonic: mnemonic}
+}
+
+// Generate a new English Electrum seed of a SeedType, as Electrum does:
+// random 132-bit numbers, starting at one that fills 12 words, are
+// incremented until their sentence has the version of the SeedType and
+// is not also a valid BIP-0039 sentence.
+// An error is returned if the SeedType is unknown or the system's
+// randomness source fails.
+func Generate(seedType SeedType) (string, error) {
+	if _, ok := versionPrefixes[seedType]; !ok {
+		return "", electrumError{Message: "Unknown Electrum seed type '" + string(seedType) + "'."}
+	}
+
+	words, err := wordlist.English.Words()
+
+	if (err != nil) { return "", electrumError{Message: err.Error()} }
+
+	limit := new(big.Int).Lsh(big.NewInt(1), SeedBits)
+	minimum := new(big.Int).Lsh(big.NewInt(1), SeedBits - gobip39.WordBitLength)
+	entropy := new(big.Int)
+
+	for entropy.Cmp(minimum) < 0 {
+		entropy, err = rand.Int(rand.Reader, limit)
+
+		if (err != nil) { return "", electrumError{Message: err.Error()} }
+	}
+
+	for {
+		entropy.Add(entropy, big.NewInt(1))
+		sentence := encode(entropy, words[:])
+
+		if found, _ := GetSeedType(sentence); found != seedType {
+			continue
+		}
+
+		if _, bip39Err := gobip39.GetMnemonicFromSentence(strings.Fields(sentence), wordlist.English); bip39Err != nil {
+			return sentence, nil
+		}
+	}
+}
+
+// Helper method that writes a number in base 2048, least significant
+// word first.
+func encode(value *big.Int, words []string) string {
+	remaining := new(big.Int).Set(value)
+	base := big.NewInt(int64(len(words)))
+	digit := new(big.Int)
+	sentence := []string{}
+
+	for remaining.Sign() > 0 {
+		remaining.DivMod(remaining, base, digit)
+		sentence = append(sentence, words[digit.Int64()])
+	}
+
+	return strings.Join(sentence, " ")
+}
+
+// Generate the binary seed of an Electrum sentence, with an optional
+// passphrase, from PBKDF2-HMAC-SHA512 salted with "electrum".
+func GenerateBinarySeed(sentence string, passphrase ...string) []byte {
+	salt := SaltPrefix
+
+	if (passphrase != nil) {
+		salt += Normalize(passphrase[0])
+	}
+
+	return pbkdf2.Key([]byte(Normalize(sentence)), []byte(salt), Pbkdf2Iterations, gobip39.KeyLengthBytes, SHA512.New)
+}
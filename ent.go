@@ -5,9 +5,9 @@ package gobip39
 
 import (
 	"crypto/sha256"
-	"crypto/rand"
 	"github.com/32bitkid/bitreader"
 	"bytes"
+	"io"
 )
 
 const (
@@ -37,25 +37,81 @@ type Entropy struct {
 // does not conform to specification limits:
 // 1) Size of Entropy (in bits) is within domain [128, 256],
 // and 2) Size of Entropy is not a multiple of 32.
-// In the case of error, the returned Entropy will be
+// An error is also returned if the system's randomness source
+// fails, or a HealthTestError if it fails its health tests,
+// which run continuously over every byte it has supplied. In
+// the case of error, the returned Entropy will be
 // in an invalid state.
 func GenerateEntropy(size uint16) (Entropy, error) {
+	source, err := getSystemReader()
+
+	if (err != nil) { return Entropy{}, err }
+
+	return GenerateEntropyFrom(size, source)
+}
+
+// Generate entropy with specified amount of bits, read from a
+// randomness source rather than the system's, such as a
+// DeterministicReader in tests or a hardware RNG.
+// An error is returned if the size is invalid as for GenerateEntropy,
+// or if the source fails or returns fewer bytes than needed. The
+// bytes read are health tested, and a HealthTestError is returned
+// if they look stuck or biased; to test a source continuously
+// across calls, pass the same HealthTestedReader over it each time.
+func GenerateEntropyFrom(size uint16, source io.Reader) (Entropy, error) {
+	if err := checkEntropySize(size); err != nil {
+		return Entropy{}, err
+	}
+
+	randomBytes, err := readRandom(source, size)
+
+	if (err != nil) { return Entropy{}, err }
+
+	// Return Entropy from these random bytes
+	return GetEntropyFromBytes(randomBytes)
+}
+
+// Helper method that reads size bits from a randomness source, health
+// testing them.
+// A HealthTestError is returned if the source looks stuck or biased,
+// and an entropyError if it fails or runs short, as partially filled
+// bytes would silently weaken the entropy.
+func readRandom(source io.Reader, size uint16) ([]byte, error) {
+	randomBytes := make([]byte, size / 8)
+
+	if err := readRandomInto(source, randomBytes); err != nil {
+		return nil, err
+	}
+
+	return randomBytes, nil
+}
+
+// Helper method that fills randomBytes from a randomness source, health
+// testing them.
+// An error is returned as for readRandom.
+func readRandomInto(source io.Reader, randomBytes []byte) error {
+	if _, err := io.ReadFull(getTestedReader(source), randomBytes); err != nil {
+		Wipe(randomBytes)
+		return getReadError(err)
+	}
+
+	return nil
+}
+
+// Helper method that returns an entropyError if size (in bits) is
+// outside the domain [128, 256] or is not a multiple of 32.
+func checkEntropySize(size uint16) error {
 	// If size is outside allowed domain
 	if (size < MinimumEntropySize || size > MaximumEntropySize) {
-		return Entropy{}, entropyError{Message: "Size of entropy is out of domain [128, 256]."}
+		return entropyError{Message: "Size of entropy is out of domain [128, 256]."}
 	}
 
 	// If size is not divisible by 32
 	if (size % 32 != 0) {
-		return Entropy{}, entropyError{Message: "Size of entropy is not a multiple of 32."}
+		return entropyError{Message: "Size of entropy is not a multiple of 32."}
 	}
 
-	// Read random bytes
-	randomBytes := make([]byte, size / 8)
-	rand.Read(randomBytes)
-
-	// Return Entropy from these random bytes
-	return GetEntropyFromBytes(randomBytes)
+	return nil
 }
 
 // Generate Entropy from hex data.
@@ -92,9 +148,11 @@ func (ent Entropy) GenerateChecksum() (byte, error) {
 	shaHash.Write(ent.Data)
 
 	// Convert data to a Reader
-	byteReader := bytes.NewReader(shaHash.Sum(nil))
+	digest := shaHash.Sum(nil)
+	byteReader := bytes.NewReader(digest)
 
 	bitReader := bitreader.NewBitReader(byteReader)
+	defer Wipe(digest)
 
 	// Read the first (entropy's bits / 32) bits of the SHA256 digest
 	checksum, err := bitReader.Read32(uint(ent.Size / 32))
@@ -0,0 +1,158 @@
+package gobip39
+
+// This file implements the continuous health tests of NIST SP 800-90B
+// section 4.4 over a randomness source's bytes: the repetition count test
+// catches a stuck source and the adaptive proportion test a biased one.
+// Cutoffs assume 8 bits of min-entropy per byte, as from a CSPRNG, with a
+// false positive rate of 2^-30 per test. A HealthTestedReader lives as long
+// as its source, so the tests run continuously across generations.
+
+import (
+	"crypto/rand"
+	"io"
+	"strconv"
+	"sync"
+)
+
+const (
+	// Failing run of identical bytes, 1 + ceil(30 / 8)
+	RepetitionCountCutoff = 5
+	// Bytes per window of the adaptive proportion test
+	AdaptiveProportionWindow = 512
+	// Failing count of a window's first byte in the window,
+	// 1 + CRITBINOM(512, 2^-8, 1 - 2^-30)
+	AdaptiveProportionCutoff = 16
+	// Bytes read and tested, then discarded, when a reader is created
+	// (SP 800-90B section 4.3 startup testing)
+	HealthTestStartupSamples = 1024
+)
+
+var (
+	// Health tested reader over rand.Reader, shared by all generations
+	systemReader *HealthTestedReader
+	systemReaderErr error
+	systemReaderOnce sync.Once
+)
+
+// Error returned when a randomness source fails a health test, naming
+// the test that failed.
+type HealthTestError struct {
+	Test string
+	Message string
+}
+
+func (err HealthTestError) Error() string {
+	return err.Test + " test failed: " + err.Message
+}
+
+// Reader that runs the health tests over every byte read from a source.
+// It fails closed: after a failure, every read returns the failure. It is
+// safe for concurrent use.
+type HealthTestedReader struct {
+	source io.Reader
+	mutex sync.Mutex
+	failure error
+	started bool
+	// Repetition count test state
+	last byte
+	run int
+	// Adaptive proportion test state
+	first byte
+	windowSize int
+	count int
+}
+
+// Create a HealthTestedReader over a randomness source, running the
+// startup test over its first HealthTestStartupSamples bytes. Keep the
+// reader for as long as the source is used, so later reads are tested
+// continuously.
+// A HealthTestError is returned if the startup test fails, or an
+// entropyError if the source fails or runs short.
+func NewHealthTestedReader(source io.Reader) (*HealthTestedReader, error) {
+	reader := &HealthTestedReader{source: source}
+	samples := make([]byte, HealthTestStartupSamples)
+	defer Wipe(samples)
+
+	if _, err := io.ReadFull(reader, samples); err != nil {
+		return nil, getReadError(err)
+	}
+
+	return reader, nil
+}
+
+// Helper method to get the HealthTestedReader over the system's
+// randomness source, created (and startup tested) on first use.
+// An error is returned if the startup test failed.
+func getSystemReader() (*HealthTestedReader, error) {
+	systemReaderOnce.Do(func() {
+		systemReader, systemReaderErr = NewHealthTestedReader(rand.Reader)
+	})
+
+	return systemReader, systemReaderErr
+}
+
+// Helper method to get a reader testing the bytes read from source. A
+// HealthTestedReader is used as is, keeping its state; any other source
+// gets a new reader, which tests only the bytes of this read.
+func getTestedReader(source io.Reader) *HealthTestedReader {
+	if tested, ok := source.(*HealthTestedReader); ok { return tested }
+
+	return &HealthTestedReader{source: source}
+}
+
+// Helper method that returns a HealthTestError as is, and wraps any
+// other read error in an entropyError.
+func getReadError(err error) error {
+	if healthErr, ok := err.(HealthTestError); ok { return healthErr }
+
+	return entropyError{Message: "Reading from the randomness source failed: " + err.Error()}
+}
+
+// Read from the source, returning a HealthTestError if the bytes read
+// fail a health test, or any error of the source.
+func (reader *HealthTestedReader) Read(data []byte) (int, error) {
+	reader.mutex.Lock()
+	defer reader.mutex.Unlock()
+
+	if (reader.failure != nil) { return 0, reader.failure }
+
+	read, err := reader.source.Read(data)
+
+	for _, sample := range data[:read] {
+		reader.test(sample)
+	}
+
+	if (reader.failure != nil) { return 0, reader.failure }
+
+	return read, err
+}
+
+// Helper method that runs both health tests on the next sample,
+// recording the first failure.
+func (reader *HealthTestedReader) test(sample byte) {
+	if (reader.started && sample == reader.last) {
+		reader.run++
+	} else {
+		reader.last, reader.run = sample, 1
+	}
+
+	reader.started = true
+
+	if (reader.run >= RepetitionCountCutoff && reader.failure == nil) {
+		reader.failure = HealthTestError{Test: "Repetition count", Message: "Source repeated a byte " + strconv.Itoa(reader.run) + " times in a row."}
+	}
+
+	if (reader.windowSize == 0) {
+		reader.first, reader.count = sample, 0
+	}
+
+	if (sample == reader.first) {
+		reader.count++
+	}
+
+	reader.windowSize = (reader.windowSize + 1) % AdaptiveProportionWindow
+
+	if (reader.count >= AdaptiveProportionCutoff && reader.failure == nil) {
+		reader.failure = HealthTestError{Test: "Adaptive proportion", Message: "Source produced a byte " + strconv.Itoa(reader.count) + " times in a window of " + strconv.Itoa(AdaptiveProportionWindow) + "."}
+	}
+}
@@ -0,0 +1,60 @@
+package gobip39
+
+// This file bundles English mnemonics published as test vectors or tool
+// defaults. Anyone can derive their wallets, so funds sent to them are
+// routinely swept.
+
+var knownMnemonics = []string{
+	// BIP-0039 test vectors
+	"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+	"legal winner thank year wave sausage worth useful legal winner thank yellow",
+	"letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+	"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+	"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon agent",
+	"legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal will",
+	"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter always",
+	"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo when",
+	"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+	"legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth title",
+	"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless",
+	"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+	"ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic",
+	"gravity machine north sort system female filter attitude volume fold club stay feature office ecology stable narrow fog",
+	"hamster diagram private dutch cause delay private meat slide toddler razor book happy fancy gospel tennis maple dilemma loan word shrug inflict delay length",
+	"scheme spot photo card baby mountain device kick cradle pact join borrow",
+	"horn tenant knee talent sponsor spell gate clip pulse soap slush warm silver nephew swap uncle crack brave",
+	"panda eyebrow bullet gorilla call smoke muffin taste mesh discover soft ostrich alcohol speed nation flash devote level hobby quick inner drive ghost inside",
+	"cat swing flag economy stadium alone churn speed unique patch report train",
+	"light rule cinnamon wrap drastic word pride squirrel upgrade then income fatal apart sustain crack supply proud access",
+	"all hour make first leader extend hole alien behind guard gospel lava path output census museum junior mass reopen famous sing advance salt reform",
+	"vessel ladder alter error federal sibling chat ability sun glass valve picture",
+	"scissors invite lock maple supreme raw rapid void congress muscle digital elegant little brisk hair mango congress clump",
+	"void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold",
+
+	// Default accounts of Ethereum development tools
+	"test test test test test test test test test test test junk",
+	"candy maple cake sugar pudding cream honey rich smooth crumble sweet treat",
+	"myth like bonus scare over problem client lizard pioneer submit female collect",
+
+	// NIP-06 test vectors
+	"leader monkey parrot ring guide accident before fence cannon height naive bean",
+	"what bleak badge arrange retreat wolf trade produce cricket blur garlic valid proud rude strong choose busy staff weather area salt hollow arm fade",
+
+	// BIP-0085 test vectors
+	"girl mad pet galaxy egg matter matrix prison refuse sense ordinary nose",
+	"near account window bike charge season chef number sketch tomorrow excuse sniff circle vital hockey outdoor supply token",
+	"puppy ocean match cereal symbol another shed magic wrap hammer bulb intact gadget divorce twin tonight reason outdoor destroy simple truth cigar social volcano",
+
+	// Seed XOR examples of Coldcard
+	"romance wink lottery autumn shop bring dawn tongue range crater truth ability miss spice fitness easy legal release recall obey exchange recycle dragon room",
+	"lion misery divide hurry latin fluid camp advance illegal lab pyramid unaware eager fringe sick camera series noodle toy crowd jeans select depth lounge",
+	"vault nominee cradle silk own frown throw leg cactus recall talent worry gadget surface shy planet purpose coffee drip few seven term squeeze educate",
+	"silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor",
+
+	// SeedQR and UR examples
+	"attack pizza motion avocado network gather crop fresh patrol unusual wild holiday candy pony ranch winter theme error hybrid van cereal salon goddess expire",
+	"shield group erode awake lock sausage cash glare wave crew flame glove",
+
+	// SEP-0005 (Stellar) test vectors
+	"illness spike retreat truth genius clock brain pass fit cave bargain toe",
+}
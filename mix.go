@@ -0,0 +1,124 @@
+package gobip39
+
+// This file mixes user-supplied entropy (e.g. dice rolls) with the
+// system's randomness, so the result is strong if either source is, and
+// keeps a transcript of both inputs so the mixing can be audited.
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	SHA512 "crypto/sha512"
+)
+
+// How random and user entropy are combined
+type MixMode uint8
+
+const (
+	// Random bytes XOR user bytes; the user bytes must be as long as the Entropy
+	MixXOR MixMode = iota
+	// HMAC-SHA512 keyed by the random bytes over the user bytes, truncated
+	// to the Entropy's size; the user bytes may be of any length
+	MixHMAC
+)
+
+// Record of a mixing, holding everything needed to reproduce its Entropy
+// offline with MixEntropy.
+type MixTranscript struct {
+	Mode MixMode
+	Random []byte
+	User []byte
+	Entropy Entropy
+}
+
+// Generate Entropy of size bits from the system's randomness mixed with
+// user-supplied entropy, returning the transcript of the mixing.
+// An error is returned if size is invalid, the user entropy does not fit
+// the mode, or the system's randomness source fails.
+func GenerateMixedEntropy(size uint16, user []byte, mode MixMode) (Entropy, MixTranscript, error) {
+	if err := checkEntropySize(size); err != nil {
+		return Entropy{}, MixTranscript{}, err
+	}
+
+	source, err := getSystemReader()
+
+	if (err != nil) { return Entropy{}, MixTranscript{}, err }
+
+	random, err := readRandom(source, size)
+
+	if (err != nil) { return Entropy{}, MixTranscript{}, err }
+
+	ent, err := MixEntropy(random, user, mode)
+
+	if (err != nil) {
+		Wipe(random)
+		return Entropy{}, MixTranscript{}, err
+	}
+
+	return ent, MixTranscript{mode, random, append([]byte{}, user...), ent}, nil
+}
+
+// Mix random bytes, whose length sets the Entropy's size, with user
+// bytes in a mode. This is deterministic, so an auditor can reproduce a
+// transcript's Entropy from its inputs.
+// An error is returned if the random bytes are not a valid Entropy size,
+// the mode is unknown, or the user bytes are empty, or for MixXOR, not
+// as long as the random bytes.
+func MixEntropy(random []byte, user []byte, mode MixMode) (Entropy, error) {
+	if (len(user) == 0) {
+		return Entropy{}, entropyError{Message: "No user entropy was supplied."}
+	}
+
+	mixed := make([]byte, len(random))
+
+	switch mode {
+	case MixXOR:
+		if (len(user) != len(random)) {
+			return Entropy{}, entropyError{Message: "User entropy must be as long as the random entropy to XOR."}
+		}
+
+		for i := range mixed {
+			mixed[i] = random[i] ^ user[i]
+		}
+	case MixHMAC:
+		mac := hmac.New(SHA512.New, random)
+		mac.Write(user)
+		digest := mac.Sum(nil)
+		copy(mixed, digest)
+		Wipe(digest)
+	default:
+		return Entropy{}, entropyError{Message: "Unknown entropy mixing mode."}
+	}
+
+	return GetEntropyFromBytes(mixed)
+}
+
+// Reproduce the transcript's Entropy from its inputs, as an auditor would.
+// An error is returned if the inputs are invalid or do not reproduce the
+// recorded Entropy.
+func (transcript MixTranscript) Verify() error {
+	ent, err := MixEntropy(transcript.Random, transcript.User, transcript.Mode)
+
+	if (err != nil) { return err }
+
+	defer ent.Destroy()
+
+	if (!hmac.Equal(ent.Data, transcript.Entropy.Data)) {
+		return entropyError{Message: "Transcript inputs do not reproduce its entropy."}
+	}
+
+	return nil
+}
+
+// Get the transcript as hex lines of its mode, inputs and Entropy.
+func (transcript MixTranscript) String() string {
+	mode := "xor"
+
+	if (transcript.Mode == MixHMAC) {
+		mode = "hmac-sha512"
+	}
+
+	return "mode: " + mode + "\n" +
+		"random: " + hex.EncodeToString(transcript.Random) + "\n" +
+		"user: " + hex.EncodeToString(transcript.User) + "\n" +
+		"entropy: " + hex.EncodeToString(transcript.Entropy.Data) + "\n"
+}
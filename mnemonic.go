@@ -5,6 +5,7 @@ package gobip39
 
 import (
 	"bytes"
+	"io"
 	"github.com/32bitkid/bitreader"
 	"gobip39/wordlist"
 )
@@ -31,7 +32,8 @@ type Mnemonic struct {
 	Sentence []uint32
 }
 
-// Generate Mnemonic from Entropy.
+// Generate Mnemonic from Entropy. The Mnemonic's Entropy shares Data
+// with ent rather than copying it; wipe both with Mnemonic.Destroy.
 // An error is returned if Entropy's size is outside
 // the domain of valid entropy size, or if the
 // size is not a multiple of 32, in which case the
@@ -65,7 +67,12 @@ func GetMnemonicFromEntropy(ent Entropy) (Mnemonic, error) {
 	// 8 - length of entropy / 32; this shifts the checksum's bits
 	// to the beginning of the byte (though, not in a different Endian
 	// style). Thus, the checksum's real value is read.
-	fullData := append(ent.Data, checksum << (8 - ent.Size / 32))
+	// fullData is a new buffer, as appending to ent.Data could write the
+	// checksum into memory shared with the caller's slice
+	fullData := make([]byte, len(ent.Data) + 1)
+	copy(fullData, ent.Data)
+	fullData[len(ent.Data)] = checksum << (8 - ent.Size / 32)
+	defer Wipe(fullData)
 
 	// Get byte reader from concatenation of entropy and checksum
 	byteReader := bytes.NewReader(fullData)
@@ -109,10 +116,26 @@ func GetMnemonicFromBytes(data []byte) (Mnemonic, error) {
 // An error is returned when entropy size is invalid (outside domain
 // [128, 256], entropy size is not a multiple of 32, or reading the
 // 11 bit portions of the entropy + checksum data fails, in which case the
-// Mnemomic returned will be in an undefined state.
+// Mnemomic returned will be in an undefined state. An error is also
+// returned if the system's randomness source fails.
 func GenerateMnemonic(size uint16) (Mnemonic, error) {
+	source, err := getSystemReader()
+
+	if (err != nil) { return Mnemonic{}, err }
+
+	return GenerateMnemonicFrom(size, source)
+}
+
+// Convenience method to generate Mnemonic with "size" bits of entropy
+// read from a randomness source, as GenerateEntropyFrom does.
+// An error is returned as for GenerateMnemonic, or if the source fails
+// or returns fewer bytes than needed. A HealthTestError is returned
+// as is if the source fails its health tests.
+func GenerateMnemonicFrom(size uint16, source io.Reader) (Mnemonic, error) {
 	// Get new entropy
-	ent, err := GenerateEntropy(size)
+	ent, err := GenerateEntropyFrom(size, source)
+
+	if healthErr, ok := err.(HealthTestError); ok { return Mnemonic{}, healthErr }
 
 	if (err != nil) { return Mnemonic{}, mnemonicError{Message: err.Error()} }
 
@@ -134,4 +157,79 @@ func (mnemonic Mnemonic) GetSentenceFrom(wordlist wordlist.Wordlist) ([]string,
 	}
 
 	return words[:], nil
-}
\ No newline at end of file
+}
+
+// Get the Mnemonic that a sentence of words from a Wordlist encodes.
+// This is the inverse of GetSentenceFrom: the words' indices are
+// looked up and passed to GetMnemonicFromIndices.
+// An error is returned if the sentence has an invalid number of words,
+// a word is not in the Wordlist, or the checksum does not match, in
+// which case the Mnemonic returned is in an invalid state.
+func GetMnemonicFromSentence(sentence []string, wl wordlist.Wordlist) (Mnemonic, error) {
+	indices := make([]uint32, len(sentence))
+	defer wipeIndices(indices)
+
+	for i, word := range sentence {
+		// The Wordlist searches itself, as not every list is sorted
+		index := wl.FindWord(word)
+
+		if (index < 0) {
+			return Mnemonic{}, mnemonicError{Message: "Word '" + word + "' is not in the " + wl.Language() + " wordlist."}
+		}
+
+		indices[i] = uint32(index)
+	}
+
+	return GetMnemonicFromIndices(indices)
+}
+
+// Get the Mnemonic that a sentence of word indices encodes. The indices
+// are concatenated, split into entropy and checksum, and the checksum is
+// verified against the entropy.
+// An error is returned if there is an invalid number of indices, an
+// index does not fit in 11 bits, or the checksum does not match, in
+// which case the Mnemonic returned is in an invalid state.
+func GetMnemonicFromIndices(indices []uint32) (Mnemonic, error) {
+	if (len(indices) < MinimumSentenceSize || len(indices) > MaximumSentenceSize || len(indices) % 3 != 0) {
+		return Mnemonic{}, mnemonicError{Message: "Number of words was not 12, 15, 18, 21 or 24."}
+	}
+
+	// Every 3 words hold 32 bits of entropy and 1 bit of checksum
+	entropyBits := len(indices) / 3 * 32
+	data := make([]byte, (len(indices) * WordBitLength + 7) / 8)
+	defer Wipe(data)
+
+	for i, index := range indices {
+		if (index >> WordBitLength != 0) {
+			return Mnemonic{}, mnemonicError{Message: "Word index was outside of domain [0, 2047]."}
+		}
+
+		// Write the word's 11 bits, most significant first
+		for bit := 0; bit < WordBitLength; bit++ {
+			if (index >> uint(WordBitLength - 1 - bit) & 1 == 1) {
+				position := i * WordBitLength + bit
+				data[position / 8] |= 1 << uint(7 - position % 8)
+			}
+		}
+	}
+
+	// The checksum bits directly follow the entropy
+	checksum := data[entropyBits / 8] >> uint(8 - entropyBits / 32)
+
+	// Copy the entropy so encoding it cannot write over the checksum
+	entropy := append([]byte{}, data[:entropyBits / 8]...)
+
+	mnemonic, err := GetMnemonicFromBytes(entropy)
+
+	if (err != nil) {
+		Wipe(entropy)
+		return Mnemonic{}, err
+	}
+
+	if (checksum != mnemonic.Checksum) {
+		mnemonic.Destroy()
+		return Mnemonic{}, mnemonicError{Message: "Checksum of sentence does not match its entropy."}
+	}
+
+	return mnemonic, nil
+}
@@ -0,0 +1,108 @@
+package nostr
+
+// This file derives Nostr keys from a mnemonic as detailed by
+// NIP-06 spec: https://github.com/nostr-protocol/nips/blob/master/06.md
+
+import (
+	"strconv"
+	"strings"
+	"gobip39"
+	"gobip39/bech32"
+	"gobip39/slip10"
+	"gobip39/wordlist"
+)
+
+const (
+	CoinType uint32 = 1237
+	PrivateKeyPrefix = "nsec"
+	PublicKeyPrefix = "npub"
+)
+
+// Error type specifically for Nostr errors
+type nostrError struct {
+	Message string
+}
+
+func (err nostrError) Error() string {
+	return err.Message
+}
+
+// Type to wrap a Nostr key pair: the secp256k1 private key and
+// its x-only public key as used by BIP-0340 Schnorr signatures.
+type Keys struct {
+	PrivateKey [32]byte
+	PublicKey [32]byte
+}
+
+// Derive the Nostr keys at m/44'/1237'/account'/0/0 from a binary seed,
+// such as the output of GenerateBinarySeed.
+// An error is returned if the seed is invalid or account is not below 2^31.
+func DeriveKeys(seed []byte, account uint32) (Keys, error) {
+	if (account >= slip10.HardenedOffset) {
+		return Keys{}, nostrError{Message: "Account index must be below 2^31."}
+	}
+
+	master, err := slip10.NewMasterKey(seed, slip10.Secp256k1)
+
+	if (err != nil) { return Keys{}, err }
+
+	key, deriveErr := master.DerivePath("m/44'/1237'/" + strconv.FormatUint(uint64(account), 10) + "'/0/0")
+
+	if (deriveErr != nil) { return Keys{}, deriveErr }
+
+	keys := Keys{PrivateKey: key.PrivateKey}
+
+	// The x-only public key drops the parity byte of the compressed point
+	copy(keys.PublicKey[:], key.PublicKey()[1:])
+
+	return keys, nil
+}
+
+// Helper method that builds the mnemonic's sentence from a Wordlist,
+// generates its binary seed with an optional passphrase, and then
+// calls DeriveKeys. The seed is wiped before returning.
+// An error is returned if the sentence cannot be built or derivation fails.
+func DeriveKeysFromMnemonic(mnemonic gobip39.Mnemonic, wl wordlist.Wordlist, account uint32, passphrase ...string) (Keys, error) {
+	sentence, err := mnemonic.GetSentenceFrom(wl)
+
+	if (err != nil) { return Keys{}, err }
+
+	seed := gobip39.GenerateBinarySeed(strings.Join(sentence, " "), passphrase...)
+
+	defer gobip39.Wipe(seed)
+
+	return DeriveKeys(seed, account)
+}
+
+// Bech32 encoding of the private key ("nsec1...").
+func (keys Keys) Nsec() (string, error) {
+	return bech32.Encode(PrivateKeyPrefix, keys.PrivateKey[:])
+}
+
+// Bech32 encoding of the public key ("npub1...").
+func (keys Keys) Npub() (string, error) {
+	return bech32.Encode(PublicKeyPrefix, keys.PublicKey[:])
+}
+
+// Decode a Bech32 encoded key, checking it has the expected prefix
+// (PrivateKeyPrefix or PublicKeyPrefix).
+// An error is returned if the string is not valid Bech32, has another
+// prefix, or does not hold 32 bytes.
+func DecodeKey(encoded string, prefix string) ([32]byte, error) {
+	var key [32]byte
+
+	hrp, data, err := bech32.Decode(encoded)
+
+	if (err != nil) { return key, err }
+
+	if (hrp != prefix) {
+		return key, nostrError{Message: "Expected prefix '" + prefix + "', got '" + hrp + "'."}
+	}
+
+	if (len(data) != len(key)) {
+		return key, nostrError{Message: "Encoded key is not 32 bytes."}
+	}
+
+	copy(key[:], data)
+	return key, nil
+}
@@ -0,0 +1,31 @@
+package polyseed
+
+// This file implements the Polyseed checksum: the phrase is a polynomial
+// over GF(2048) (reduced by x^11 + x^2 + 1) that evaluates to zero at x = 2.
+
+const (
+	gfBits = 11
+	gfSize = 1 << gfBits
+	gfPolynomial = 0x805
+)
+
+// Multiply an element by 2.
+func multiply2(x uint16) uint16 {
+	if (x < gfSize / 2) {
+		return x << 1
+	}
+
+	return x << 1 ^ gfPolynomial
+}
+
+// Evaluate the polynomial at x = 2 with Horner's method, taking the
+// coefficients in order of increasing degree.
+func evaluate(coefficients []uint16) uint16 {
+	result := coefficients[len(coefficients) - 1]
+
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		result = multiply2(result) ^ coefficients[i]
+	}
+
+	return result
+}
@@ -0,0 +1,252 @@
+package polyseed
+
+// This file implements Polyseed mnemonics as detailed by
+// Polyseed spec: https://github.com/tevador/polyseed
+// 16 words of a BIP-0039 wordlist hold an 11-bit checksum followed by
+// 15 words of 10 secret bits and 1 bit of the birthday and features.
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"time"
+	SHA256 "crypto/sha256"
+	"golang.org/x/crypto/pbkdf2"
+	"gobip39/wordlist"
+)
+
+const (
+	SentenceSize = 16
+	SecretBits = 150
+	SecretSize = (SecretBits + 7) / 8
+	DateBits = 10
+	FeatureBits = 5
+	// Features that may be set by users; the others are reserved
+	UserFeatures = 0x07
+	// Feature marking a secret encrypted by a passphrase
+	EncryptedFeature = 0x10
+	// Birthdays count time steps of 1/12 Gregorian year since 1 November 2021
+	Epoch = 1635768000
+	TimeStep = 2629746
+	KeyIterations = 10000
+	shareBits = 10
+	dataWords = SentenceSize - 1
+)
+
+// Coin a phrase is for; phrases of one coin fail the checksum of another
+type Coin uint16
+
+const (
+	Monero Coin = 0
+	Aeon Coin = 1
+	Wownero Coin = 2
+)
+
+// Error type specifically for Polyseed errors
+type polyseedError struct {
+	Message string
+}
+
+func (err polyseedError) Error() string {
+	return err.Message
+}
+
+var (
+	// Returned when a phrase fails its checksum, including a phrase of
+	// another coin
+	ErrInvalidChecksum = polyseedError{Message: "Checksum of Polyseed phrase is invalid."}
+	// Returned when deriving a key from a secret still encrypted by a
+	// passphrase, which would open the wrong wallet
+	ErrEncrypted = polyseedError{Message: "Polyseed secret is encrypted by a passphrase."}
+)
+
+// Type to wrap the contents of a Polyseed phrase.
+type Seed struct {
+	// 150 bits, the last byte holding the low 6
+	Secret [SecretSize]byte
+	Birthday uint16
+	Features uint8
+}
+
+// Generate a Seed with a random secret, born at a time, with user
+// features (bits of UserFeatures).
+// An error is returned if a non-user feature is set or the system's
+// randomness source fails.
+func Generate(birthday time.Time, features uint8) (Seed, error) {
+	if (features & ^uint8(UserFeatures) != 0) {
+		return Seed{}, polyseedError{Message: "Only user features may be set."}
+	}
+
+	seed := Seed{Birthday: EncodeBirthday(birthday), Features: features}
+
+	if _, err := rand.Read(seed.Secret[:]); err != nil {
+		return Seed{}, polyseedError{Message: err.Error()}
+	}
+
+	seed.Secret[SecretSize - 1] &= 0xFF >> (SecretSize * 8 - SecretBits)
+
+	return seed, nil
+}
+
+// Encode a time as a birthday, wrapping around every 1024 time steps.
+// Times before the epoch encode as 0.
+func EncodeBirthday(birthday time.Time) uint16 {
+	if (birthday.Unix() < Epoch) {
+		return 0
+	}
+
+	return uint16((birthday.Unix() - Epoch) / TimeStep) & (1 << DateBits - 1)
+}
+
+// The start of the time step of the seed's birthday.
+func (seed Seed) BirthdayTime() time.Time {
+	return time.Unix(Epoch + int64(seed.Birthday) * TimeStep, 0).UTC()
+}
+
+// Whether the secret is encrypted by a passphrase.
+func (seed Seed) IsEncrypted() bool {
+	return seed.Features & EncryptedFeature != 0
+}
+
+// Get the phrase of the seed for a coin in a Wordlist.
+// An error is returned if the birthday or features are out of range, or
+// reading from the Wordlist fails.
+func (seed Seed) GetSentence(wl wordlist.Wordlist, coin Coin) ([]string, error) {
+	if (seed.Birthday >> DateBits != 0 || seed.Features >> FeatureBits != 0) {
+		return []string{}, polyseedError{Message: "Birthday or features are out of range."}
+	}
+
+	coefficients := seed.coefficients()
+	coefficients[0] = evaluate(coefficients)
+	coefficients[1] ^= uint16(coin)
+
+	sentence := make([]string, SentenceSize)
+
+	for i, coefficient := range coefficients {
+		var err error
+		sentence[i], err = wl.GetWordAt(uint32(coefficient))
+
+		if (err != nil) { return []string{}, polyseedError{Message: err.Error()} }
+	}
+
+	return sentence, nil
+}
+
+// Helper method that spreads the secret, birthday and features over the
+// data coefficients, leaving the checksum coefficient 0.
+func (seed Seed) coefficients() []uint16 {
+	coefficients := make([]uint16, SentenceSize)
+	extra := uint16(seed.Features) << DateBits | seed.Birthday
+
+	for i := 0; i < dataWords; i++ {
+		var share uint16
+
+		for bit := i * shareBits; bit < (i + 1) * shareBits; bit++ {
+			share = share << 1 | uint16(seed.secretBit(bit))
+		}
+
+		coefficients[i + 1] = share << 1 | extra >> uint(dataWords - 1 - i) & 1
+	}
+
+	return coefficients
+}
+
+// Helper method to read bit i of the 150-bit secret, most significant
+// first; the last byte only holds its low bits.
+func (seed Seed) secretBit(i int) byte {
+	if (i < (SecretSize - 1) * 8) {
+		return seed.Secret[i / 8] >> uint(7 - i % 8) & 1
+	}
+
+	return seed.Secret[SecretSize - 1] >> uint(SecretBits - 1 - i) & 1
+}
+
+// Decode a Polyseed phrase of a coin from a Wordlist. Like the reference
+// implementation, user features are reserved unless enabled, so phrases
+// using them are rejected; see DecodeWithFeatures.
+// An error is returned if the phrase does not have 16 words, a word is
+// not in the Wordlist, the checksum fails (also the case for another
+// coin's phrase), or a reserved feature is set.
+func Decode(sentence []string, wl wordlist.Wordlist, coin Coin) (Seed, error) {
+	return DecodeWithFeatures(sentence, wl, coin, 0)
+}
+
+// Decode a Polyseed phrase as Decode does, also accepting the user
+// features enabled (bits of UserFeatures), as the reference's
+// polyseed_enable_features does.
+// An error is returned as for Decode, a feature outside enabled and
+// EncryptedFeature counting as reserved.
+func DecodeWithFeatures(sentence []string, wl wordlist.Wordlist, coin Coin, enabled uint8) (Seed, error) {
+	if (len(sentence) != SentenceSize) {
+		return Seed{}, polyseedError{Message: "Polyseed phrases have 16 words."}
+	}
+
+	coefficients := make([]uint16, SentenceSize)
+
+	for i, word := range sentence {
+		index := wl.FindWord(word)
+
+		if (index < 0) {
+			return Seed{}, polyseedError{Message: "Word '" + word + "' is not in the " + wl.Language() + " wordlist."}
+		}
+
+		coefficients[i] = uint16(index)
+	}
+
+	coefficients[1] ^= uint16(coin)
+
+	if (evaluate(coefficients) != 0) {
+		return Seed{}, ErrInvalidChecksum
+	}
+
+	var seed Seed
+	var extra uint16
+
+	for i := 0; i < dataWords; i++ {
+		extra = extra << 1 | coefficients[i + 1] & 1
+		share := coefficients[i + 1] >> 1
+
+		for bit := 0; bit < shareBits; bit++ {
+			if (share >> uint(shareBits - 1 - bit) & 1 == 1) {
+				seed.setSecretBit(i * shareBits + bit)
+			}
+		}
+	}
+
+	seed.Birthday = extra & (1 << DateBits - 1)
+	seed.Features = uint8(extra >> DateBits)
+
+	if (seed.Features & ^(enabled & UserFeatures | EncryptedFeature) != 0) {
+		return Seed{}, polyseedError{Message: "Polyseed phrase uses unsupported features."}
+	}
+
+	return seed, nil
+}
+
+// Helper method to set bit i of the 150-bit secret, the inverse of
+// secretBit.
+func (seed *Seed) setSecretBit(i int) {
+	if (i < (SecretSize - 1) * 8) {
+		seed.Secret[i / 8] |= 1 << uint(7 - i % 8)
+	} else {
+		seed.Secret[SecretSize - 1] |= 1 << uint(SecretBits - 1 - i)
+	}
+}
+
+// Derive a key of size bytes for a coin, e.g. a 32-byte Monero spend
+// key seed, with PBKDF2-HMAC-SHA256 of the secret salted with the coin,
+// birthday and features.
+// ErrEncrypted is returned if the secret is encrypted by a passphrase.
+func (seed Seed) Key(coin Coin, size int) ([]byte, error) {
+	if (seed.IsEncrypted()) {
+		return nil, ErrEncrypted
+	}
+
+	salt := make([]byte, 32)
+	copy(salt, "POLYSEED key")
+	salt[13], salt[14], salt[15] = 0xFF, 0xFF, 0xFF
+	binary.LittleEndian.PutUint32(salt[16:], uint32(coin))
+	binary.LittleEndian.PutUint32(salt[20:], uint32(seed.Birthday))
+	binary.LittleEndian.PutUint32(salt[24:], uint32(seed.Features))
+
+	return pbkdf2.Key(seed.Secret[:], salt, KeyIterations, size, SHA256.New), nil
+}
@@ -0,0 +1,593 @@
+package qr
+
+// This file implements QR code (model 2) generation as detailed by
+// ISO/IEC 18004:2015, following the structure of Project Nayuki's
+// reference encoder: https://www.nayuki.io/page/qr-code-generator-library
+
+import (
+	"strings"
+)
+
+const (
+	MinimumVersion = 1
+	MaximumVersion = 40
+	// Light modules required around a code by the standard
+	QuietZone = 4
+)
+
+// Error type specifically for QR code errors
+type qrError struct {
+	Message string
+}
+
+func (err qrError) Error() string {
+	return err.Message
+}
+
+// Error correction level, recovering about 7%, 15%, 25% or 30% of codewords.
+type Level int
+
+const (
+	Low Level = iota
+	Medium
+	Quartile
+	High
+)
+
+// Characters of alphanumeric mode, in order of their values
+const alphanumericCharset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// Get the Level named by its letter "L", "M", "Q" or "H" (in any case).
+// An error is returned for any other name.
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToUpper(name) {
+	case "L": return Low, nil
+	case "M": return Medium, nil
+	case "Q": return Quartile, nil
+	case "H": return High, nil
+	}
+
+	return Low, qrError{Message: "Unknown error correction level '" + name + "'."}
+}
+
+// Value of each Level in the format information
+var formatBits = [4]int{1, 0, 3, 2}
+
+// Segment encoding mode
+type mode struct {
+	indicator int
+	// Character count bits for versions 1-9, 10-26 and 27-40
+	countBits [3]int
+}
+
+var (
+	numericMode = mode{indicator: 0x1, countBits: [3]int{10, 12, 14}}
+	alphanumericMode = mode{indicator: 0x2, countBits: [3]int{9, 11, 13}}
+	byteMode = mode{indicator: 0x4, countBits: [3]int{8, 16, 16}}
+)
+
+func (m mode) characterCountBits(version int) int {
+	return m.countBits[(version + 7) / 17]
+}
+
+// Type to wrap a QR code symbol: its version, error correction Level and
+// square grid of modules.
+type Code struct {
+	Version int
+	Level Level
+	Size int
+	modules []bool
+	function []bool
+}
+
+// Whether the module at column x and row y is dark. Modules outside the
+// symbol, such as its quiet zone, are light.
+func (code Code) Dark(x int, y int) bool {
+	return x >= 0 && y >= 0 && x < code.Size && y < code.Size && code.modules[y * code.Size + x]
+}
+
+// Encode text in the most compact single mode that holds all of it:
+// numeric for digits only, alphanumeric for uppercase letters, digits
+// and " $%*+-./:", and UTF-8 bytes otherwise. URs are best uppercased
+// first so that they fit alphanumeric mode.
+// An error is returned if the text does not fit in version 40.
+func Encode(text string, level Level) (Code, error) {
+	numeric := true
+	alphanumeric := true
+
+	for i := 0; i < len(text); i++ {
+		numeric = numeric && text[i] >= '0' && text[i] <= '9'
+		alphanumeric = alphanumeric && strings.IndexByte(alphanumericCharset, text[i]) >= 0
+	}
+
+	if (numeric) {
+		return EncodeNumeric(text, level)
+	}
+
+	if (alphanumeric) {
+		return EncodeAlphanumeric(text, level)
+	}
+
+	return EncodeBytes([]byte(text), level)
+}
+
+// Encode a string of decimal digits in numeric mode in the smallest
+// version that fits at a Level.
+// An error is returned if a character is not a digit or the digits do
+// not fit in version 40.
+func EncodeNumeric(digits string, level Level) (Code, error) {
+	var data bitBuffer
+
+	for i := 0; i < len(digits); i += 3 {
+		group := 0
+		length := 0
+
+		for ; length < 3 && i + length < len(digits); length++ {
+			digit := digits[i + length]
+
+			if (digit < '0' || digit > '9') {
+				return Code{}, qrError{Message: "Numeric mode only encodes the digits 0-9."}
+			}
+
+			group = group * 10 + int(digit - '0')
+		}
+
+		// 3 digits take 10 bits, 2 digits 7 bits and 1 digit 4 bits
+		data.append(group, length * 3 + 1)
+	}
+
+	return encode(numericMode, len(digits), data, level)
+}
+
+// Encode a string of alphanumeric mode characters (uppercase letters,
+// digits and " $%*+-./:") in the smallest version that fits at a Level.
+// An error is returned if a character is outside the charset or the
+// text does not fit in version 40.
+func EncodeAlphanumeric(text string, level Level) (Code, error) {
+	var data bitBuffer
+
+	for i := 0; i < len(text); i += 2 {
+		group := 0
+		length := 0
+
+		for ; length < 2 && i + length < len(text); length++ {
+			value := strings.IndexByte(alphanumericCharset, text[i + length])
+
+			if (value < 0) {
+				return Code{}, qrError{Message: "Alphanumeric mode only encodes 0-9, A-Z and \" $%*+-./:\"."}
+			}
+
+			group = group * len(alphanumericCharset) + value
+		}
+
+		// 2 characters take 11 bits and 1 character 6 bits
+		data.append(group, length * 5 + 1)
+	}
+
+	return encode(alphanumericMode, len(text), data, level)
+}
+
+// Encode arbitrary bytes in byte mode in the smallest version that fits
+// at a Level.
+// An error is returned if the data does not fit in version 40.
+func EncodeBytes(data []byte, level Level) (Code, error) {
+	var bits bitBuffer
+
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+
+	return encode(byteMode, len(data), bits, level)
+}
+
+// Helper method that builds a single segment code in the smallest
+// version that fits.
+func encode(m mode, count int, data bitBuffer, level Level) (Code, error) {
+	if (level < Low || level > High) {
+		return Code{}, qrError{Message: "Invalid error correction level."}
+	}
+
+	version := MinimumVersion
+
+	for ; version <= MaximumVersion; version++ {
+		countBits := m.characterCountBits(version)
+
+		if (count < 1 << uint(countBits) && 4 + countBits + len(data) <= dataCodewords(version, level) * 8) {
+			break
+		}
+	}
+
+	if (version > MaximumVersion) {
+		return Code{}, qrError{Message: "Data is too long for a QR code."}
+	}
+
+	var bits bitBuffer
+	bits.append(m.indicator, 4)
+	bits.append(count, m.characterCountBits(version))
+	bits = append(bits, data...)
+
+	// Terminator, byte alignment, then alternating pad bytes
+	capacity := dataCodewords(version, level) * 8
+	terminator := capacity - len(bits)
+	if (terminator > 4) {
+		terminator = 4
+	}
+
+	bits.append(0, terminator)
+	bits.append(0, (8 - len(bits) % 8) % 8)
+
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+
+	codewords := make([]byte, len(bits) / 8)
+	for i, bit := range bits {
+		if (bit) {
+			codewords[i / 8] |= 1 << uint(7 - i % 8)
+		}
+	}
+
+	return newCode(version, level, addErrorCorrection(codewords, version, level)), nil
+}
+
+// Helper method that splits data codewords into blocks, appends each
+// block's error correction codewords and interleaves the blocks.
+func addErrorCorrection(data []byte, version int, level Level) []byte {
+	blocks := eccBlocks[level][version]
+	eccLength := eccCodewordsPerBlock[level][version]
+	rawCodewords := rawDataModules(version) / 8
+	shortBlocks := blocks - rawCodewords % blocks
+	shortBlockLength := rawCodewords / blocks
+
+	divisor := reedSolomonDivisor(eccLength)
+	allBlocks := make([][]byte, blocks)
+
+	for i, offset := 0, 0; i < blocks; i++ {
+		length := shortBlockLength - eccLength
+		if (i >= shortBlocks) {
+			length++
+		}
+
+		block := append([]byte{}, data[offset:offset + length]...)
+		offset += length
+		ecc := reedSolomonRemainder(block, divisor)
+
+		// Short blocks get a placeholder so every block has equal length
+		if (i < shortBlocks) {
+			block = append(block, 0)
+		}
+
+		allBlocks[i] = append(block, ecc...)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := range allBlocks[0] {
+		for j, block := range allBlocks {
+			if (i != shortBlockLength - eccLength || j >= shortBlocks) {
+				result = append(result, block[i])
+			}
+		}
+	}
+
+	return result
+}
+
+// Helper method that draws the function patterns and codewords, then
+// applies the mask with the lowest penalty.
+func newCode(version int, level Level, codewords []byte) Code {
+	size := version * 4 + 17
+	code := Code{Version: version, Level: level, Size: size, modules: make([]bool, size * size), function: make([]bool, size * size)}
+
+	code.drawFunctionPatterns()
+	code.drawCodewords(codewords)
+
+	bestMask := 0
+	bestPenalty := -1
+
+	for mask := 0; mask < 8; mask++ {
+		code.applyMask(mask)
+		code.drawFormatBits(mask)
+
+		if penalty := code.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask = mask
+			bestPenalty = penalty
+		}
+
+		// Masking is its own inverse
+		code.applyMask(mask)
+	}
+
+	code.applyMask(bestMask)
+	code.drawFormatBits(bestMask)
+	code.function = nil
+
+	return code
+}
+
+func (code *Code) set(x int, y int, dark bool) {
+	code.modules[y * code.Size + x] = dark
+}
+
+func (code *Code) setFunction(x int, y int, dark bool) {
+	code.set(x, y, dark)
+	code.function[y * code.Size + x] = true
+}
+
+func (code *Code) drawFunctionPatterns() {
+	size := code.Size
+
+	// Timing patterns
+	for i := 0; i < size; i++ {
+		code.setFunction(6, i, i % 2 == 0)
+		code.setFunction(i, 6, i % 2 == 0)
+	}
+
+	// Finder patterns and their separators
+	for _, centre := range [][2]int{{3, 3}, {size - 4, 3}, {3, size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x := centre[0] + dx
+				y := centre[1] + dy
+
+				if (x >= 0 && x < size && y >= 0 && y < size) {
+					distance := chebyshev(dx, dy)
+					code.setFunction(x, y, distance != 2 && distance != 4)
+				}
+			}
+		}
+	}
+
+	// Alignment patterns, except where they would overlap finders
+	positions := alignmentPositions(code.Version)
+	last := len(positions) - 1
+
+	for i, y := range positions {
+		for j, x := range positions {
+			if ((i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0)) {
+				continue
+			}
+
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					code.setFunction(x + dx, y + dy, chebyshev(dx, dy) != 1)
+				}
+			}
+		}
+	}
+
+	// Reserve the format area, then draw the version information
+	code.drawFormatBits(0)
+
+	if (code.Version >= 7) {
+		remainder := code.Version
+		for i := 0; i < 12; i++ {
+			remainder = remainder << 1 ^ (remainder >> 11) * 0x1F25
+		}
+
+		bits := code.Version << 12 | remainder
+
+		for i := 0; i < 18; i++ {
+			dark := bits >> uint(i) & 1 == 1
+			a := size - 11 + i % 3
+			b := i / 3
+			code.setFunction(a, b, dark)
+			code.setFunction(b, a, dark)
+		}
+	}
+}
+
+func (code *Code) drawFormatBits(mask int) {
+	data := formatBits[code.Level] << 3 | mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = remainder << 1 ^ (remainder >> 9) * 0x537
+	}
+
+	bits := (data << 10 | remainder) ^ 0x5412
+	bit := func(i int) bool {
+		return bits >> uint(i) & 1 == 1
+	}
+
+	size := code.Size
+
+	// Copy around the top left finder
+	for i := 0; i <= 5; i++ {
+		code.setFunction(8, i, bit(i))
+	}
+	code.setFunction(8, 7, bit(6))
+	code.setFunction(8, 8, bit(7))
+	code.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		code.setFunction(14 - i, 8, bit(i))
+	}
+
+	// Copy split between the other two finders
+	for i := 0; i < 8; i++ {
+		code.setFunction(size - 1 - i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		code.setFunction(8, size - 15 + i, bit(i))
+	}
+	code.setFunction(8, size - 8, true)
+}
+
+// Helper method that places codewords in the zigzag of two-module wide
+// columns, skipping function modules.
+func (code *Code) drawCodewords(codewords []byte) {
+	size := code.Size
+	i := 0
+
+	for right := size - 1; right >= 1; right -= 2 {
+		// The vertical timing pattern takes a whole column
+		if (right == 6) {
+			right = 5
+		}
+
+		upward := (right + 1) & 2 == 0
+
+		for vertical := 0; vertical < size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vertical
+				if (upward) {
+					y = size - 1 - vertical
+				}
+
+				if (!code.function[y * size + x] && i < len(codewords) * 8) {
+					code.set(x, y, codewords[i >> 3] >> uint(7 - i & 7) & 1 == 1)
+					i++
+				}
+			}
+		}
+	}
+}
+
+func (code *Code) applyMask(mask int) {
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			var invert bool
+
+			switch mask {
+			case 0: invert = (x + y) % 2 == 0
+			case 1: invert = y % 2 == 0
+			case 2: invert = x % 3 == 0
+			case 3: invert = (x + y) % 3 == 0
+			case 4: invert = (x / 3 + y / 2) % 2 == 0
+			case 5: invert = x * y % 2 + x * y % 3 == 0
+			case 6: invert = (x * y % 2 + x * y % 3) % 2 == 0
+			case 7: invert = ((x + y) % 2 + x * y % 3) % 2 == 0
+			}
+
+			index := y * code.Size + x
+			code.modules[index] = code.modules[index] != (invert && !code.function[index])
+		}
+	}
+}
+
+// Penalty score of the current modules, as used to choose a mask.
+func (code *Code) penalty() int {
+	size := code.Size
+	result := 0
+
+	// Runs of 5 or more and finder-like patterns, in rows then columns
+	for _, transpose := range []bool{false, true} {
+		for a := 0; a < size; a++ {
+			runDark := false
+			runLength := 0
+			history := make([]int, 7)
+
+			for b := 0; b < size; b++ {
+				dark := code.Dark(b, a)
+				if (transpose) {
+					dark = code.Dark(a, b)
+				}
+
+				if (dark == runDark) {
+					runLength++
+
+					if (runLength == 5) {
+						result += 3
+					} else if (runLength > 5) {
+						result++
+					}
+				} else {
+					code.addRunToHistory(runLength, history)
+
+					if (!runDark) {
+						result += countFinderPatterns(history) * 40
+					}
+
+					runDark = dark
+					runLength = 1
+				}
+			}
+
+			// Terminate the line as if followed by light modules
+			if (runDark) {
+				code.addRunToHistory(runLength, history)
+				runLength = 0
+			}
+
+			code.addRunToHistory(runLength + size, history)
+			result += countFinderPatterns(history) * 40
+		}
+	}
+
+	// 2x2 blocks of one colour
+	for y := 0; y < size - 1; y++ {
+		for x := 0; x < size - 1; x++ {
+			dark := code.Dark(x, y)
+
+			if (dark == code.Dark(x + 1, y) && dark == code.Dark(x, y + 1) && dark == code.Dark(x + 1, y + 1)) {
+				result += 3
+			}
+		}
+	}
+
+	// Balance of dark and light modules
+	dark := 0
+	for _, module := range code.modules {
+		if (module) {
+			dark++
+		}
+	}
+
+	total := size * size
+	result += ((abs(dark * 20 - total * 10) + total - 1) / total - 1) * 10
+
+	return result
+}
+
+// Helper method that pushes a run length onto the front of a history,
+// counting the light border before the first run.
+func (code *Code) addRunToHistory(length int, history []int) {
+	if (history[0] == 0) {
+		length += code.Size
+	}
+
+	copy(history[1:], history[:len(history) - 1])
+	history[0] = length
+}
+
+// Number of 1:1:3:1:1 finder-like patterns with 4 light modules on a side.
+func countFinderPatterns(history []int) int {
+	n := history[1]
+	core := n > 0 && history[2] == n && history[3] == n * 3 && history[4] == n && history[5] == n
+	count := 0
+
+	if (core && history[0] >= n * 4 && history[6] >= n) {
+		count++
+	}
+
+	if (core && history[6] >= n * 4 && history[0] >= n) {
+		count++
+	}
+
+	return count
+}
+
+// Distance from a pattern's centre in whole rings.
+func chebyshev(dx int, dy int) int {
+	if (abs(dx) > abs(dy)) {
+		return abs(dx)
+	}
+
+	return abs(dy)
+}
+
+func abs(x int) int {
+	if (x < 0) {
+		return -x
+	}
+
+	return x
+}
+
+// Sequence of bits, most significant first
+type bitBuffer []bool
+
+func (buffer *bitBuffer) append(value int, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*buffer = append(*buffer, value >> uint(i) & 1 == 1)
+	}
+}
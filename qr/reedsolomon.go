@@ -0,0 +1,56 @@
+package qr
+
+// This file computes Reed-Solomon error correction codewords over GF(256)
+// with the QR code's reducing polynomial x^8 + x^4 + x^3 + x^2 + 1.
+
+// Multiply two elements of GF(256).
+func multiply(a byte, b byte) byte {
+	var result byte
+
+	for i := 7; i >= 0; i-- {
+		result = result << 1 ^ byte(0x1D * int(result >> 7))
+		result ^= byte(int(b >> uint(i) & 1) * int(a))
+	}
+
+	return result
+}
+
+// Coefficients of the generator polynomial of a degree, highest first
+// and omitting the leading 1.
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree - 1] = 1
+	root := byte(1)
+
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = multiply(result[j], root)
+
+			if (j + 1 < len(result)) {
+				result[j] ^= result[j + 1]
+			}
+		}
+
+		root = multiply(root, 0x02)
+	}
+
+	return result
+}
+
+// Remainder of the data polynomial divided by the generator polynomial,
+// which are the error correction codewords.
+func reedSolomonRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result) - 1] = 0
+
+		for i := range result {
+			result[i] ^= multiply(divisor[i], factor)
+		}
+	}
+
+	return result
+}
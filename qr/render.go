@@ -0,0 +1,95 @@
+package qr
+
+// This file renders QR codes as PNG and SVG images and terminal text, all
+// with a light quiet zone.
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+	"strings"
+)
+
+// Encode the code as a black and white PNG where every module is a
+// square of scale pixels.
+// An error is returned if scale is not positive or PNG encoding fails.
+func (code Code) PNG(scale int) ([]byte, error) {
+	if (scale < 1) {
+		return []byte{}, qrError{Message: "Scale must be at least 1 pixel per module."}
+	}
+
+	width := (code.Size + QuietZone * 2) * scale
+	img := image.NewPaletted(image.Rect(0, 0, width, width), color.Palette{color.White, color.Black})
+
+	for y := 0; y < width; y++ {
+		for x := 0; x < width; x++ {
+			if (code.Dark(x / scale - QuietZone, y / scale - QuietZone)) {
+				img.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	if err := png.Encode(&buffer, img); err != nil {
+		return []byte{}, qrError{Message: err.Error()}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// Encode the code as an SVG document measured in modules, so it scales
+// without loss. Dark modules form a single path.
+func (code Code) SVG() string {
+	width := strconv.Itoa(code.Size + QuietZone * 2)
+
+	var path strings.Builder
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if (code.Dark(x, y)) {
+				path.WriteString("M" + strconv.Itoa(x + QuietZone) + "," + strconv.Itoa(y + QuietZone) + "h1v1h-1z")
+			}
+		}
+	}
+
+	return `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 ` + width + " " + width + `" shape-rendering="crispEdges">` +
+		`<rect width="100%" height="100%" fill="#FFFFFF"/>` +
+		`<path d="` + path.String() + `" fill="#000000"/></svg>`
+}
+
+// Render the code as lines of Unicode half blocks, two module rows per
+// line, for printing to a terminal. Blocks draw dark modules, which suits
+// light backgrounds; on dark backgrounds pass darkBackground so that
+// blocks draw light modules instead and the code is not inverted.
+func (code Code) Terminal(darkBackground bool) string {
+	blocks := [4]string{" ", "\u2580", "\u2584", "\u2588"}
+	inked := func(x int, y int) bool {
+		return code.Dark(x - QuietZone, y - QuietZone) != darkBackground
+	}
+
+	width := code.Size + QuietZone * 2
+
+	var text strings.Builder
+	for y := 0; y < width; y += 2 {
+		for x := 0; x < width; x++ {
+			block := 0
+
+			if (inked(x, y)) {
+				block |= 1
+			}
+
+			// The last line of an odd height has a light lower half
+			if ((y + 1 < width && inked(x, y + 1)) || (y + 1 == width && darkBackground)) {
+				block |= 2
+			}
+
+			text.WriteString(blocks[block])
+		}
+
+		text.WriteString("\n")
+	}
+
+	return text.String()
+}
@@ -0,0 +1,59 @@
+package qr
+
+// This file holds the error correction layout of every QR code version as
+// detailed by ISO/IEC 18004:2015, tables 9 and 13.
+
+// Error correction codewords per block, indexed by Level then version
+var eccCodewordsPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// Error correction blocks, indexed by Level then version
+var eccBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// Number of modules left for codewords once function patterns are drawn.
+func rawDataModules(version int) int {
+	result := (16 * version + 128) * version + 64
+
+	if (version >= 2) {
+		alignments := version / 7 + 2
+		result -= (25 * alignments - 10) * alignments - 55
+
+		if (version >= 7) {
+			result -= 36
+		}
+	}
+
+	return result
+}
+
+// Number of 8-bit data codewords a version holds at a Level.
+func dataCodewords(version int, level Level) int {
+	return rawDataModules(version) / 8 - eccCodewordsPerBlock[level][version] * eccBlocks[level][version]
+}
+
+// Centre coordinates of the alignment patterns of a version.
+func alignmentPositions(version int) []int {
+	if (version == 1) {
+		return []int{}
+	}
+
+	count := version / 7 + 2
+	step := (version * 8 + count * 3 + 5) / (count * 4 - 4) * 2
+	positions := make([]int, count)
+	positions[0] = 6
+
+	for i, position := count - 1, version * 4 + 10; i >= 1; i, position = i - 1, position - step {
+		positions[i] = position
+	}
+
+	return positions
+}
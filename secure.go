@@ -0,0 +1,193 @@
+package gobip39
+
+// This file generates Entropy and binary seeds in securemem buffers, which
+// on Linux are locked into RAM and excluded from core dumps, so the secrets
+// never reach swap. The buffers must be destroyed by the caller.
+
+import (
+	"hash"
+	"golang.org/x/text/unicode/norm"
+	SHA512 "crypto/sha512"
+	"gobip39/securemem"
+)
+
+// Upper bound on how many times NFKD can lengthen a UTF-8 string
+const maximumNFKDExpansion = 18
+
+// Generate entropy with specified amount of bits, as GenerateEntropy does,
+// into a secure buffer. The Entropy's Data is the buffer's bytes, so it is
+// unusable once the buffer is destroyed.
+// An error is returned as for GenerateEntropy, or if the secure buffer
+// cannot be allocated (e.g. on platforms other than Linux).
+func GenerateSecureEntropy(size uint16) (Entropy, *securemem.Buffer, error) {
+	if err := checkEntropySize(size); err != nil {
+		return Entropy{}, nil, err
+	}
+
+	buffer, err := securemem.New(int(size / 8))
+
+	if (err != nil) { return Entropy{}, nil, entropyError{Message: err.Error()} }
+
+	source, err := getSystemReader()
+
+	if (err != nil) {
+		buffer.Destroy()
+		return Entropy{}, nil, err
+	}
+
+	if err := readRandomInto(source, buffer.Bytes()); err != nil {
+		buffer.Destroy()
+		return Entropy{}, nil, err
+	}
+
+	return Entropy{Size: size, Data: buffer.Bytes()}, buffer, nil
+}
+
+// Generate the binary seed, as GenerateBinarySeed does, into a secure
+// buffer. The normalized mnemonic and passphrase, the HMAC key pads and
+// the intermediate PBKDF2 blocks are kept in secure buffers too, and
+// destroyed before returning; only the SHA-512 chaining state remains
+// on the Go heap, where it cannot be locked, and it is reset after use.
+// An error is returned if a secure buffer cannot be allocated (e.g. on
+// platforms other than Linux).
+func GenerateSecureBinarySeed(mnemonic string, passphrase ...string) (*securemem.Buffer, error) {
+	// mnemonic is a required prefix in all passphrases
+	_passphrase := "mnemonic"
+
+	// If there are any arguments passed, assume the first one is the passphrase.
+	if (passphrase != nil) {
+		_passphrase += passphrase[0]
+	}
+
+	mnemonicBuffer, normalizedMnemonic, err := normalizeSecurely(mnemonic)
+
+	if (err != nil) { return nil, err }
+
+	defer mnemonicBuffer.Destroy()
+
+	passphraseBuffer, normalizedPassphrase, err := normalizeSecurely(_passphrase)
+
+	if (err != nil) { return nil, err }
+
+	defer passphraseBuffer.Destroy()
+
+	// Holds both key pads, the inner hash and U, the latest HMAC output
+	state, err := securemem.New(2 * SHA512.BlockSize + 2 * SHA512.Size)
+
+	if (err != nil) { return nil, entropyError{Message: err.Error()} }
+
+	defer state.Destroy()
+
+	seed, err := securemem.New(KeyLengthBytes)
+
+	if (err != nil) { return nil, entropyError{Message: err.Error()} }
+
+	prf := newSecureHMAC(state.Bytes(), normalizedMnemonic)
+
+	defer prf.reset()
+
+	// As the seed is exactly one SHA-512 output, PBKDF2 has one block:
+	// U1 = HMAC(mnemonic, passphrase || 1), Ui = HMAC(mnemonic, Ui-1)
+	// and the seed is U1 ^ U2 ^ ... ^ U2048
+	u := prf.sum(normalizedPassphrase, []byte{0, 0, 0, 1})
+	copy(seed.Bytes(), u)
+
+	for i := 1; i < Pbkdf2Iterations; i++ {
+		u = prf.sum(u)
+
+		for j := range u {
+			seed.Bytes()[j] ^= u[j]
+		}
+	}
+
+	return seed, nil
+}
+
+// HMAC-SHA512 whose key pads, inner hash and output live in a caller's
+// secure buffer, unlike crypto/hmac which keeps its pads on the heap.
+type secureHMAC struct {
+	inner hash.Hash
+	outer hash.Hash
+	innerPad []byte
+	outerPad []byte
+	innerSum []byte
+	output []byte
+}
+
+// Helper method that lays the HMAC out over state, which must hold
+// 2 * SHA512.BlockSize + 2 * SHA512.Size bytes, and derives the key
+// pads from key.
+func newSecureHMAC(state []byte, key []byte) secureHMAC {
+	prf := secureHMAC{
+		inner: SHA512.New(),
+		outer: SHA512.New(),
+		innerPad: state[:SHA512.BlockSize],
+		outerPad: state[SHA512.BlockSize:2 * SHA512.BlockSize],
+		innerSum: state[2 * SHA512.BlockSize:2 * SHA512.BlockSize + SHA512.Size],
+		output: state[2 * SHA512.BlockSize + SHA512.Size:],
+	}
+
+	// Keys longer than a block are hashed first
+	if (len(key) > SHA512.BlockSize) {
+		prf.inner.Write(key)
+		prf.inner.Sum(prf.innerPad[:0])
+		prf.inner.Reset()
+	} else {
+		copy(prf.innerPad, key)
+	}
+
+	copy(prf.outerPad, prf.innerPad)
+
+	for i := range prf.innerPad {
+		prf.innerPad[i] ^= 0x36
+		prf.outerPad[i] ^= 0x5c
+	}
+
+	return prf
+}
+
+// Helper method to compute the HMAC of the concatenated messages. The
+// returned slice is overwritten by the next call.
+func (prf secureHMAC) sum(messages ...[]byte) []byte {
+	prf.inner.Reset()
+	prf.inner.Write(prf.innerPad)
+
+	for _, message := range messages {
+		prf.inner.Write(message)
+	}
+
+	prf.inner.Sum(prf.innerSum[:0])
+
+	prf.outer.Reset()
+	prf.outer.Write(prf.outerPad)
+	prf.outer.Write(prf.innerSum)
+
+	return prf.outer.Sum(prf.output[:0])
+}
+
+// Helper method that resets both hashes, so their state no longer
+// depends on the key.
+func (prf secureHMAC) reset() {
+	prf.inner.Reset()
+	prf.outer.Reset()
+}
+
+// Helper method that NFKD normalizes a string into a secure buffer,
+// returning the buffer and the normalized bytes within it.
+// An error is returned if the buffer cannot be allocated.
+func normalizeSecurely(text string) (*securemem.Buffer, []byte, error) {
+	buffer, err := securemem.New(len(text) * maximumNFKDExpansion + 1)
+
+	if (err != nil) { return nil, nil, entropyError{Message: err.Error()} }
+
+	normalized := norm.NFKD.AppendString(buffer.Bytes()[:0], text)
+
+	// Appending past the buffer would have moved the text to the heap
+	if (len(normalized) > len(buffer.Bytes())) {
+		Wipe(normalized)
+		buffer.Destroy()
+		return nil, nil, entropyError{Message: "Normalized text does not fit its secure buffer."}
+	}
+
+	return buffer, normalized, nil
+}
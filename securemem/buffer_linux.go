@@ -0,0 +1,76 @@
+//go:build linux
+
+package securemem
+
+// This file allocates secure buffers on Linux with mmap, mlock and
+// madvise(MADV_DONTDUMP), between PROT_NONE guard pages.
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// Allocate a Buffer of size bytes, zeroed.
+// An error is returned if size is not positive, or mapping, protecting or
+// locking the memory fails, e.g. when it exceeds RLIMIT_MEMLOCK.
+func New(size int) (*Buffer, error) {
+	if (size < 1) {
+		return nil, securememError{Message: "Size of secure buffer must be positive."}
+	}
+
+	pageSize := unix.Getpagesize()
+	dataPages := (size + pageSize - 1) / pageSize
+	region, err := unix.Mmap(-1, 0, (dataPages + 2) * pageSize, unix.PROT_READ | unix.PROT_WRITE, unix.MAP_PRIVATE | unix.MAP_ANONYMOUS)
+
+	if (err != nil) { return nil, securememError{Message: "Mapping secure buffer failed: " + err.Error()} }
+
+	buffer := &Buffer{region: region, inner: region[pageSize:len(region) - pageSize]}
+	buffer.data = buffer.inner[len(buffer.inner) - size:]
+
+	if err := buffer.protect(pageSize); err != nil {
+		unix.Munmap(region)
+		return nil, err
+	}
+
+	return buffer, nil
+}
+
+// Helper method that turns the first and last pages into guard pages,
+// excludes the mapping from core dumps and locks the inner pages.
+// An error is returned if any of these fail.
+func (buffer *Buffer) protect(pageSize int) error {
+	if err := unix.Mprotect(buffer.region[:pageSize], unix.PROT_NONE); err != nil {
+		return securememError{Message: "Protecting guard page failed: " + err.Error()}
+	}
+
+	if err := unix.Mprotect(buffer.region[len(buffer.region) - pageSize:], unix.PROT_NONE); err != nil {
+		return securememError{Message: "Protecting guard page failed: " + err.Error()}
+	}
+
+	if err := unix.Madvise(buffer.region, unix.MADV_DONTDUMP); err != nil {
+		return securememError{Message: "Excluding secure buffer from core dumps failed: " + err.Error()}
+	}
+
+	if err := unix.Mlock(buffer.inner); err != nil {
+		return securememError{Message: "Locking secure buffer failed: " + err.Error()}
+	}
+
+	return nil
+}
+
+// Zero, unlock and unmap the buffer. Destroying a Buffer again does nothing.
+// An error is returned if unmapping fails.
+func (buffer *Buffer) Destroy() error {
+	if (buffer.region == nil) { return nil }
+
+	for i := range buffer.inner {
+		buffer.inner[i] = 0
+	}
+
+	unix.Munlock(buffer.inner)
+	err := unix.Munmap(buffer.region)
+	buffer.region, buffer.inner, buffer.data = nil, nil, nil
+
+	if (err != nil) { return securememError{Message: "Unmapping secure buffer failed: " + err.Error()} }
+
+	return nil
+}
@@ -0,0 +1,17 @@
+//go:build !linux
+
+package securemem
+
+// This file stands in for secure buffers on platforms other than Linux,
+// where they are not supported.
+
+// Allocate a Buffer of size bytes.
+// An error is always returned, as secure buffers need Linux.
+func New(size int) (*Buffer, error) {
+	return nil, securememError{Message: "Secure buffers are only supported on Linux."}
+}
+
+// Destroy the buffer, which does nothing as none can be allocated.
+func (buffer *Buffer) Destroy() error {
+	return nil
+}
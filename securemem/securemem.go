@@ -0,0 +1,31 @@
+package securemem
+
+// This file defines buffers for secret material kept out of swap and core
+// dumps. On Linux they are mapped outside the Go heap, locked into RAM,
+// excluded from core dumps and surrounded by inaccessible guard pages; other
+// platforms are not supported.
+
+// Error type specifically for secure buffer errors
+type securememError struct {
+	Message string
+}
+
+func (err securememError) Error() string {
+	return err.Message
+}
+
+// Buffer of locked, non-swappable memory. Its bytes must not be used after
+// Destroy, which unmaps them.
+type Buffer struct {
+	// Whole mapping, guard pages included
+	region []byte
+	// Pages between the guard pages
+	inner []byte
+	data []byte
+}
+
+// Get the buffer's bytes. They end at the trailing guard page, so writing
+// past them faults rather than reaching other memory.
+func (buffer *Buffer) Bytes() []byte {
+	return buffer.data
+}
@@ -16,6 +16,7 @@ const (
 )
 
 // Generate the binary seed, with an optional passphrase, from a mnemonic sentence.
+// The seed is owned by the caller, who should Wipe it once it is no longer needed.
 func GenerateBinarySeed(mnemonic string, passphrase ...string) []byte{
 	// mnemonic is a required prefix in all passphrases
 	_passphrase := "mnemonic"
@@ -30,5 +31,11 @@ func GenerateBinarySeed(mnemonic string, passphrase ...string) []byte{
 
 	// The reason we do not have to pass HMAC-SHA512.New is because of the fact that
 	// pbkdf2.Key will call HMAC on passwords for you.
-	return pbkdf2.Key(normalizedMnemonic, normalizedPassphrase, Pbkdf2Iterations, KeyLengthBytes, SHA512.New)
+	seed := pbkdf2.Key(normalizedMnemonic, normalizedPassphrase, Pbkdf2Iterations, KeyLengthBytes, SHA512.New)
+
+	// Zero the normalized copies of the mnemonic and passphrase
+	Wipe(normalizedMnemonic)
+	Wipe(normalizedPassphrase)
+
+	return seed
 }
@@ -0,0 +1,103 @@
+package seedqr
+
+// This file implements SeedSigner's SeedQR formats as detailed by
+// SeedQR spec: https://github.com/SeedSigner/seedsigner/blob/dev/docs/seed_qr/README.md
+// Standard SeedQR holds every word's index as 4 decimal digits; CompactSeedQR
+// holds the raw entropy bytes.
+
+import (
+	"strconv"
+	"gobip39"
+	"gobip39/qr"
+)
+
+const (
+	DigitsPerWord = 4
+	// SeedQR codes are always generated at the lowest error correction
+	Level = qr.Low
+)
+
+// Error type specifically for SeedQR errors
+type seedQRError struct {
+	Message string
+}
+
+func (err seedQRError) Error() string {
+	return err.Message
+}
+
+// Encode the Mnemonic's word indices as a Standard SeedQR digit string,
+// e.g. "0000" for the first word of the wordlist.
+func Encode(mnemonic gobip39.Mnemonic) string {
+	digits := make([]byte, 0, len(mnemonic.Sentence) * DigitsPerWord)
+
+	for _, index := range mnemonic.Sentence {
+		word := strconv.FormatUint(uint64(index), 10)
+
+		for i := len(word); i < DigitsPerWord; i++ {
+			digits = append(digits, '0')
+		}
+
+		digits = append(digits, word...)
+	}
+
+	return string(digits)
+}
+
+// Decode a Standard SeedQR digit string into a Mnemonic.
+// An error is returned if the string is not made of 4-digit word indices
+// of a valid sentence, or if its checksum does not match.
+func Decode(digits string) (gobip39.Mnemonic, error) {
+	if (len(digits) % DigitsPerWord != 0) {
+		return gobip39.Mnemonic{}, seedQRError{Message: "SeedQR must hold 4 digits per word."}
+	}
+
+	indices := make([]uint32, len(digits) / DigitsPerWord)
+
+	for i := range indices {
+		word := digits[i * DigitsPerWord:(i + 1) * DigitsPerWord]
+		index, err := strconv.ParseUint(word, 10, 32)
+
+		if (err != nil) {
+			return gobip39.Mnemonic{}, seedQRError{Message: "SeedQR word '" + word + "' is not a number."}
+		}
+
+		indices[i] = uint32(index)
+	}
+
+	return gobip39.GetMnemonicFromIndices(indices)
+}
+
+// Encode the Mnemonic's entropy as CompactSeedQR bytes. The checksum is
+// left out, as it is recomputed on decoding.
+func EncodeCompact(mnemonic gobip39.Mnemonic) []byte {
+	return append([]byte{}, mnemonic.Entropy.Data...)
+}
+
+// Decode CompactSeedQR bytes into a Mnemonic.
+// An error is returned if the bytes are not a valid entropy size.
+func DecodeCompact(data []byte) (gobip39.Mnemonic, error) {
+	return gobip39.GetMnemonicFromBytes(append([]byte{}, data...))
+}
+
+// Generate the Standard SeedQR code of a Mnemonic, which is a numeric
+// mode QR code (version 2 for 12 words, version 3 for 24 words).
+// An error is returned if the Mnemonic has no words.
+func QR(mnemonic gobip39.Mnemonic) (qr.Code, error) {
+	if (len(mnemonic.Sentence) == 0) {
+		return qr.Code{}, seedQRError{Message: "Mnemonic has no words."}
+	}
+
+	return qr.EncodeNumeric(Encode(mnemonic), Level)
+}
+
+// Generate the CompactSeedQR code of a Mnemonic, which is a byte mode QR
+// code (version 1 for 12 words, version 2 for 24 words).
+// An error is returned if the Mnemonic's entropy is invalid.
+func CompactQR(mnemonic gobip39.Mnemonic) (qr.Code, error) {
+	if _, err := gobip39.GetEntropyFromBytes(mnemonic.Entropy.Data); err != nil {
+		return qr.Code{}, seedQRError{Message: err.Error()}
+	}
+
+	return qr.EncodeBytes(EncodeCompact(mnemonic), Level)
+}
@@ -0,0 +1,110 @@
+package seedxor
+
+// This file implements Seed XOR as used by Coldcard: https://seedxor.com
+// Entropy is split into parts whose XOR equals the original, and every
+// part is itself a valid BIP-0039 mnemonic of the same length.
+
+import (
+	"crypto/rand"
+	"gobip39"
+	"gobip39/wordlist"
+)
+
+const (
+	MinimumParts = 2
+)
+
+// Error type specifically for Seed XOR errors
+type seedXorError struct {
+	Message string
+}
+
+func (err seedXorError) Error() string {
+	return err.Message
+}
+
+// Split Entropy into parts Mnemonics. All but the last part are random;
+// the last is the XOR of the Entropy with the others. Each part is
+// encoded through GetMnemonicFromBytes, so it carries a correct checksum.
+// An error is returned if parts is below 2, the Entropy is invalid,
+// or the system's randomness source fails.
+func Split(ent gobip39.Entropy, parts int) ([]gobip39.Mnemonic, error) {
+	if (parts < MinimumParts) {
+		return []gobip39.Mnemonic{}, seedXorError{Message: "Seed XOR needs at least 2 parts."}
+	}
+
+	if _, err := gobip39.GetEntropyFromBytes(ent.Data); err != nil {
+		return []gobip39.Mnemonic{}, seedXorError{Message: err.Error()}
+	}
+
+	mnemonics := make([]gobip39.Mnemonic, parts)
+	last := append([]byte{}, ent.Data...)
+
+	for i := 0; i < parts - 1; i++ {
+		data := make([]byte, len(ent.Data))
+
+		if _, err := rand.Read(data); err != nil {
+			return []gobip39.Mnemonic{}, seedXorError{Message: err.Error()}
+		}
+
+		xorInto(last, data)
+
+		var err error
+		mnemonics[i], err = gobip39.GetMnemonicFromBytes(data)
+
+		if (err != nil) { return []gobip39.Mnemonic{}, seedXorError{Message: err.Error()} }
+	}
+
+	var err error
+	mnemonics[parts - 1], err = gobip39.GetMnemonicFromBytes(last)
+
+	if (err != nil) { return []gobip39.Mnemonic{}, seedXorError{Message: err.Error()} }
+
+	return mnemonics, nil
+}
+
+// Combine parts back into the original Mnemonic by XORing their entropy.
+// Parts may be given in any order.
+// An error is returned if there are fewer than 2 parts or their
+// entropy sizes differ.
+func Combine(parts []gobip39.Mnemonic) (gobip39.Mnemonic, error) {
+	if (len(parts) < MinimumParts) {
+		return gobip39.Mnemonic{}, seedXorError{Message: "Seed XOR needs at least 2 parts."}
+	}
+
+	combined := make([]byte, len(parts[0].Entropy.Data))
+
+	for _, part := range parts {
+		if (len(part.Entropy.Data) != len(combined)) {
+			return gobip39.Mnemonic{}, seedXorError{Message: "All parts must have the same number of words."}
+		}
+
+		xorInto(combined, part.Entropy.Data)
+	}
+
+	return gobip39.GetMnemonicFromBytes(combined)
+}
+
+// Helper method that parses each sentence with GetMnemonicFromSentence
+// and then calls Combine.
+// An error is returned if a sentence is not a valid mnemonic or
+// combining fails.
+func CombineSentences(sentences [][]string, wl wordlist.Wordlist) (gobip39.Mnemonic, error) {
+	parts := make([]gobip39.Mnemonic, len(sentences))
+
+	for i, sentence := range sentences {
+		var err error
+		parts[i], err = gobip39.GetMnemonicFromSentence(sentence, wl)
+
+		if (err != nil) { return gobip39.Mnemonic{}, err }
+	}
+
+	return Combine(parts)
+}
+
+// Helper method to XOR source into destination.
+func xorInto(destination []byte, source []byte) {
+	for i := range destination {
+		destination[i] ^= source[i]
+	}
+}
@@ -0,0 +1,114 @@
+package shamir
+
+// This file implements Shamir's secret sharing with a digest share
+// protecting the recovered secret, as used by SLIP-0039 and SSKR.
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	SHA256 "crypto/sha256"
+)
+
+const (
+	DigestLength = 4
+	DigestIndex = 254
+	SecretIndex = 255
+)
+
+// Split secret into count shares at x = 0 to count - 1, any threshold of
+// which recover it. When threshold is above 1, the polynomial also passes
+// through a digest share at x = 254 so that recovery can detect invalid
+// shares, and the secret itself sits at x = 255.
+// An error is returned if threshold or count are out of range, the
+// secret is too short to hold a digest, or the system's randomness
+// source fails.
+func SplitSecret(threshold int, count int, secret []byte) ([]Point, error) {
+	if (threshold < 1 || threshold > count || count > DigestIndex) {
+		return []Point{}, shamirError{Message: "Threshold must be between 1 and the share count."}
+	}
+
+	if (len(secret) < DigestLength) {
+		return []Point{}, shamirError{Message: "Secret is too short to hold a digest."}
+	}
+
+	shares := make([]Point, count)
+
+	// Without a threshold, every share is the secret itself
+	if (threshold == 1) {
+		for i := range shares {
+			shares[i] = Point{X: byte(i), Data: append([]byte{}, secret...)}
+		}
+
+		return shares, nil
+	}
+
+	randomShareCount := threshold - 2
+	basePoints := make([]Point, 0, threshold)
+
+	for i := 0; i < randomShareCount; i++ {
+		data := make([]byte, len(secret))
+
+		if _, err := rand.Read(data); err != nil {
+			return []Point{}, shamirError{Message: err.Error()}
+		}
+
+		shares[i] = Point{X: byte(i), Data: data}
+		basePoints = append(basePoints, shares[i])
+	}
+
+	randomPart := make([]byte, len(secret) - DigestLength)
+
+	if _, err := rand.Read(randomPart); err != nil {
+		return []Point{}, shamirError{Message: err.Error()}
+	}
+
+	digestShare := append(createDigest(randomPart, secret), randomPart...)
+
+	basePoints = append(basePoints, Point{X: DigestIndex, Data: digestShare}, Point{X: SecretIndex, Data: secret})
+
+	for i := randomShareCount; i < count; i++ {
+		data, err := Interpolate(basePoints, byte(i))
+
+		if (err != nil) { return []Point{}, err }
+
+		shares[i] = Point{X: byte(i), Data: data}
+	}
+
+	return shares, nil
+}
+
+// Recover the secret from threshold shares created by SplitSecret,
+// checking it against the digest share.
+// An error is returned if there are no shares, interpolation fails or
+// the digest does not match.
+func RecoverSecret(threshold int, shares []Point) ([]byte, error) {
+	if (len(shares) == 0) {
+		return []byte{}, shamirError{Message: "No shares were provided."}
+	}
+
+	if (threshold == 1) {
+		return append([]byte{}, shares[0].Data...), nil
+	}
+
+	secret, err := Interpolate(shares, SecretIndex)
+
+	if (err != nil) { return []byte{}, err }
+
+	digestShare, digestErr := Interpolate(shares, DigestIndex)
+
+	if (digestErr != nil) { return []byte{}, digestErr }
+
+	if (!hmac.Equal(digestShare[:DigestLength], createDigest(digestShare[DigestLength:], secret))) {
+		return []byte{}, shamirError{Message: "Invalid digest of the shared secret."}
+	}
+
+	return secret, nil
+}
+
+// Helper method to compute the first 4 bytes of HMAC-SHA256(randomPart, secret).
+func createDigest(randomPart []byte, secret []byte) []byte {
+	mac := hmac.New(SHA256.New, randomPart)
+	mac.Write(secret)
+
+	return mac.Sum(nil)[:DigestLength]
+}
@@ -0,0 +1,97 @@
+package shamir
+
+// This file implements arithmetic and Lagrange interpolation over GF(256)
+// with the Rijndael polynomial x^8 + x^4 + x^3 + x + 1.
+
+var gfExp [255]byte
+var gfLog [256]byte
+
+// Build logarithm tables using 3 as the generator.
+func init() {
+	value := byte(1)
+
+	for i := 0; i < 255; i++ {
+		gfExp[i] = value
+		gfLog[value] = byte(i)
+
+		// value *= 3, i.e. value ^ (value * 2)
+		doubled := value << 1
+		if (value & 0x80 != 0) {
+			doubled ^= 0x1B
+		}
+
+		value ^= doubled
+	}
+}
+
+// Multiply two GF(256) elements.
+func Multiply(a byte, b byte) byte {
+	if (a == 0 || b == 0) {
+		return 0
+	}
+
+	return gfExp[(int(gfLog[a]) + int(gfLog[b])) % 255]
+}
+
+// Divide two GF(256) elements. b must not be 0.
+func Divide(a byte, b byte) byte {
+	if (a == 0) {
+		return 0
+	}
+
+	return gfExp[(int(gfLog[a]) - int(gfLog[b]) + 255) % 255]
+}
+
+// A point on a sharing polynomial: the x coordinate and the
+// polynomial evaluated at x for every byte of the secret.
+type Point struct {
+	X byte
+	Data []byte
+}
+
+// Evaluate the polynomial passing through points at x using
+// Lagrange interpolation.
+// An error is returned if there are no points, the x coordinates are
+// not unique or the data lengths differ.
+func Interpolate(points []Point, x byte) ([]byte, error) {
+	if (len(points) == 0) {
+		return []byte{}, shamirError{Message: "Cannot interpolate without points."}
+	}
+
+	for i := range points {
+		if (len(points[i].Data) != len(points[0].Data)) {
+			return []byte{}, shamirError{Message: "Share values must all have the same length."}
+		}
+
+		for j := 0; j < i; j++ {
+			if (points[i].X == points[j].X) {
+				return []byte{}, shamirError{Message: "Share indices must be unique."}
+			}
+		}
+	}
+
+	for _, p := range points {
+		if (p.X == x) {
+			return append([]byte{}, p.Data...), nil
+		}
+	}
+
+	result := make([]byte, len(points[0].Data))
+
+	for i, current := range points {
+		// Lagrange basis polynomial of current evaluated at x
+		basis := byte(1)
+
+		for j, other := range points {
+			if (i != j) {
+				basis = Multiply(basis, Divide(x ^ other.X, current.X ^ other.X))
+			}
+		}
+
+		for k := range result {
+			result[k] ^= Multiply(basis, current.Data[k])
+		}
+	}
+
+	return result, nil
+}
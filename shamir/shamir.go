@@ -0,0 +1,158 @@
+package shamir
+
+// This file splits Entropy into Shamir shares which are themselves valid
+// BIP-0039 mnemonics, so each share can be written on a standard backup card.
+// Shares are not compatible with SLIP-0039; use the slip39 package for that.
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strconv"
+	"gobip39"
+	"gobip39/wordlist"
+)
+
+const (
+	MaximumShareCount = 255
+)
+
+// Error type specifically for Shamir errors
+type shamirError struct {
+	Message string
+}
+
+func (err shamirError) Error() string {
+	return err.Message
+}
+
+// Type to wrap a share: its index (the x coordinate, from 1 to 255), the
+// number of shares needed to recover the secret, and the share data as
+// a BIP-0039 Mnemonic. Index and Threshold are not part of the mnemonic
+// and must be recorded alongside it.
+type Share struct {
+	Index byte
+	Threshold byte
+	Mnemonic gobip39.Mnemonic
+}
+
+// Split Entropy into count shares, any threshold of which recover it.
+// Each share has the same size as the Entropy and is encoded through
+// GetMnemonicFromBytes.
+// An error is returned if threshold or count are out of range, the
+// Entropy is invalid, or the system's randomness source fails.
+func Split(ent gobip39.Entropy, threshold byte, count byte) ([]Share, error) {
+	if (threshold < 1 || threshold > count) {
+		return []Share{}, shamirError{Message: "Threshold must be between 1 and the share count."}
+	}
+
+	if _, err := gobip39.GetEntropyFromBytes(ent.Data); err != nil {
+		return []Share{}, shamirError{Message: err.Error()}
+	}
+
+	// The polynomial's constant term is the secret, the rest are random
+	coefficients := make([][]byte, threshold)
+	coefficients[0] = ent.Data
+
+	for i := 1; i < int(threshold); i++ {
+		coefficients[i] = make([]byte, len(ent.Data))
+
+		if _, err := rand.Read(coefficients[i]); err != nil {
+			return []Share{}, shamirError{Message: err.Error()}
+		}
+	}
+
+	shares := make([]Share, count)
+
+	for i := range shares {
+		x := byte(i + 1)
+		data := evaluate(coefficients, x)
+
+		mnemonic, err := gobip39.GetMnemonicFromBytes(data)
+
+		if (err != nil) { return []Share{}, shamirError{Message: err.Error()} }
+
+		shares[i] = Share{Index: x, Threshold: threshold, Mnemonic: mnemonic}
+	}
+
+	return shares, nil
+}
+
+// Recover the Entropy from at least threshold shares.
+// Shares beyond the threshold are checked against the polynomial defined
+// by the first threshold shares, so a mistyped or foreign share is detected
+// whenever more shares than needed are supplied. With exactly threshold
+// shares no such check is possible.
+// An error is returned if there are too few shares, the shares disagree
+// on their threshold or size, an index repeats, or the shares are
+// inconsistent.
+func Combine(shares []Share) (gobip39.Entropy, error) {
+	if (len(shares) == 0) {
+		return gobip39.Entropy{}, shamirError{Message: "No shares were provided."}
+	}
+
+	threshold := shares[0].Threshold
+
+	points := make([]Point, len(shares))
+
+	for i, share := range shares {
+		if (share.Threshold != threshold) {
+			return gobip39.Entropy{}, shamirError{Message: "Shares disagree on the threshold."}
+		}
+
+		if (share.Index == 0) {
+			return gobip39.Entropy{}, shamirError{Message: "Share index 0 is reserved for the secret."}
+		}
+
+		if (share.Mnemonic.Entropy.Size != shares[0].Mnemonic.Entropy.Size) {
+			return gobip39.Entropy{}, shamirError{Message: "Shares disagree on the entropy size."}
+		}
+
+		points[i] = Point{X: share.Index, Data: share.Mnemonic.Entropy.Data}
+	}
+
+	if (len(shares) < int(threshold)) {
+		return gobip39.Entropy{}, shamirError{Message: "Insufficient number of shares to meet the threshold."}
+	}
+
+	secret, err := Interpolate(points[:threshold], 0)
+
+	if (err != nil) { return gobip39.Entropy{}, err }
+
+	for _, extra := range points[threshold:] {
+		expected, extraErr := Interpolate(points[:threshold], extra.X)
+
+		if (extraErr != nil) { return gobip39.Entropy{}, extraErr }
+
+		if (!bytes.Equal(expected, extra.Data)) {
+			return gobip39.Entropy{}, shamirError{Message: "Inconsistent shares; share " + strconv.Itoa(int(extra.X)) + " does not match the others."}
+		}
+	}
+
+	return gobip39.GetEntropyFromBytes(secret)
+}
+
+// Helper method that parses a share's written sentence with
+// GetMnemonicFromSentence and attaches its index and threshold.
+// An error is returned if the sentence is not a valid mnemonic.
+func ParseShare(index byte, threshold byte, sentence []string, wl wordlist.Wordlist) (Share, error) {
+	mnemonic, err := gobip39.GetMnemonicFromSentence(sentence, wl)
+
+	if (err != nil) { return Share{}, err }
+
+	return Share{Index: index, Threshold: threshold, Mnemonic: mnemonic}, nil
+}
+
+// Helper method to evaluate the polynomial with coefficients at x
+// using Horner's method.
+func evaluate(coefficients [][]byte, x byte) []byte {
+	result := make([]byte, len(coefficients[0]))
+
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		for k := range result {
+			result[k] = Multiply(result[k], x) ^ coefficients[i][k]
+		}
+	}
+
+	return result
+}
+
@@ -0,0 +1,91 @@
+package slip10
+
+// This file handles extended key serialization ("xprv" and "xpub") as detailed by
+// BIP-0032 spec: https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#serialization-format
+
+import (
+	SHA256 "crypto/sha256"
+	"encoding/binary"
+	"golang.org/x/crypto/ripemd160"
+	"gobip39/base58"
+)
+
+const (
+	PrivateVersion uint32 = 0x0488ADE4
+	PublicVersion uint32 = 0x0488B21E
+	SerializedKeyLength = 78
+)
+
+// First 4 bytes of RIPEMD160(SHA256(public key)), which identifies
+// this key as the parent of its children.
+func (key Key) Fingerprint() [4]byte {
+	shaDigest := SHA256.Sum256(key.PublicKey())
+
+	ripemd := ripemd160.New()
+	ripemd.Write(shaDigest[:])
+
+	var fingerprint [4]byte
+	copy(fingerprint[:], ripemd.Sum(nil))
+
+	return fingerprint
+}
+
+// Serialize the key as a Base58Check extended private key ("xprv...").
+// An error is returned if the key is not a secp256k1 key.
+func (key Key) Serialize() (string, error) {
+	if (key.Curve != Secp256k1) {
+		return "", slip10Error{Message: "Only secp256k1 keys can be serialized."}
+	}
+
+	return base58.CheckEncode(key.serialize(PrivateVersion, append([]byte{0x00}, key.PrivateKey[:]...))), nil
+}
+
+// Serialize the key's public key as a Base58Check extended public key ("xpub...").
+// An error is returned if the key is not a secp256k1 key.
+func (key Key) SerializePublic() (string, error) {
+	if (key.Curve != Secp256k1) {
+		return "", slip10Error{Message: "Only secp256k1 keys can be serialized."}
+	}
+
+	return base58.CheckEncode(key.serialize(PublicVersion, key.PublicKey())), nil
+}
+
+// Helper method to lay out the 78 byte serialization format.
+func (key Key) serialize(version uint32, keyData []byte) []byte {
+	data := make([]byte, 0, SerializedKeyLength)
+	data = binary.BigEndian.AppendUint32(data, version)
+	data = append(data, key.Depth)
+	data = append(data, key.ParentFingerprint[:]...)
+	data = binary.BigEndian.AppendUint32(data, key.ChildNumber)
+	data = append(data, key.ChainCode[:]...)
+
+	return append(data, keyData...)
+}
+
+// Parse a Base58Check extended private key ("xprv...") into a secp256k1 Key.
+// An error is returned if the string is not valid Base58Check, is not
+// an extended private key, or holds an invalid private key.
+func ParseExtendedKey(encoded string) (Key, error) {
+	data, err := base58.CheckDecode(encoded)
+
+	if (err != nil) { return Key{}, err }
+
+	if (len(data) != SerializedKeyLength) {
+		return Key{}, slip10Error{Message: "Extended key is not 78 bytes."}
+	}
+
+	if (binary.BigEndian.Uint32(data[:4]) != PrivateVersion || data[45] != 0x00) {
+		return Key{}, slip10Error{Message: "Extended key is not an extended private key."}
+	}
+
+	key := Key{Curve: Secp256k1, Depth: data[4], ChildNumber: binary.BigEndian.Uint32(data[9:13])}
+	copy(key.ParentFingerprint[:], data[5:9])
+	copy(key.ChainCode[:], data[13:45])
+	copy(key.PrivateKey[:], data[46:])
+
+	if _, ok := Secp256k1.childPrivateKey(make([]byte, KeyLength), key.PrivateKey[:]); !ok {
+		return Key{}, slip10Error{Message: "Extended key holds an invalid private key."}
+	}
+
+	return key, nil
+}
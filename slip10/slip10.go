@@ -0,0 +1,237 @@
+package slip10
+
+// This file wraps hierarchical deterministic key derivation as detailed by
+// SLIP-0010 spec: https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	SHA512 "crypto/sha512"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	HardenedOffset uint32 = 0x80000000
+	KeyLength = 32
+	MinimumSeedLength = 16
+	MaximumSeedLength = 64
+)
+
+// Curves that keys may be derived for.
+type Curve int
+
+const (
+	Ed25519 Curve = iota
+	Secp256k1
+)
+
+// Error type specifically for SLIP-0010 errors
+type slip10Error struct {
+	Message string
+}
+
+func (err slip10Error) Error() string {
+	return err.Message
+}
+
+// Type to wrap an extended private key and its position in the tree
+type Key struct {
+	Curve Curve
+	Depth byte
+	ParentFingerprint [4]byte
+	ChildNumber uint32
+	ChainCode [KeyLength]byte
+	PrivateKey [KeyLength]byte
+}
+
+// Returns the HMAC key used to derive a master key for the curve.
+func (curve Curve) seedKey() string {
+	switch curve {
+	case Ed25519:
+		return "ed25519 seed"
+	case Secp256k1:
+		return "Bitcoin seed"
+	}
+
+	return ""
+}
+
+// Returns the child private key for the curve given the parent's private
+// key and the left half of the derivation digest, and whether that child
+// is valid. Ed25519 uses the digest directly, secp256k1 adds it to the
+// parent modulo the curve order.
+func (curve Curve) childPrivateKey(parent []byte, digest []byte) ([KeyLength]byte, bool) {
+	var child [KeyLength]byte
+
+	if (curve == Ed25519) {
+		copy(child[:], digest)
+		return child, true
+	}
+
+	var tweak, scalar secp256k1.ModNScalar
+
+	if (tweak.SetByteSlice(digest)) {
+		return child, false
+	}
+
+	scalar.SetByteSlice(parent)
+	scalar.Add(&tweak)
+
+	if (scalar.IsZero()) {
+		return child, false
+	}
+
+	scalar.PutBytes(&child)
+	return child, true
+}
+
+// Generate the master key for a curve from a binary seed, such as the
+// output of GenerateBinarySeed.
+// An error is returned if the seed is outside the domain [16, 64] bytes
+// or the curve is unknown, in which case the Key returned is in an
+// invalid state.
+func NewMasterKey(seed []byte, curve Curve) (Key, error) {
+	if (len(seed) < MinimumSeedLength || len(seed) > MaximumSeedLength) {
+		return Key{}, slip10Error{Message: "Length of seed (in bytes) was outside of domain [16, 64]."}
+	}
+
+	if (curve.seedKey() == "") {
+		return Key{}, slip10Error{Message: "Unknown curve."}
+	}
+
+	mac := hmac.New(SHA512.New, []byte(curve.seedKey()))
+	mac.Write(seed)
+	digest := mac.Sum(nil)
+
+	// An invalid secp256k1 key is replaced by hashing the digest again
+	for {
+		if _, ok := curve.childPrivateKey(make([]byte, KeyLength), digest[:KeyLength]); ok {
+			break
+		}
+
+		mac = hmac.New(SHA512.New, []byte(curve.seedKey()))
+		mac.Write(digest)
+		digest = mac.Sum(nil)
+	}
+
+	key := Key{Curve: curve}
+	copy(key.PrivateKey[:], digest[:KeyLength])
+	copy(key.ChainCode[:], digest[KeyLength:])
+
+	return key, nil
+}
+
+// Derive the child key at index. Indices at or above HardenedOffset are
+// hardened. Ed25519 only supports hardened derivation, so an error is
+// returned if index is below HardenedOffset for an Ed25519 key.
+func (key Key) Derive(index uint32) (Key, error) {
+	if (index < HardenedOffset && key.Curve == Ed25519) {
+		return Key{}, slip10Error{Message: "Ed25519 only supports hardened derivation."}
+	}
+
+	if (key.Depth == 0xFF) {
+		return Key{}, slip10Error{Message: "Maximum derivation depth reached."}
+	}
+
+	// Hardened children are derived from 0x00 || private key || index,
+	// normal children from public key || index
+	var data []byte
+
+	if (index >= HardenedOffset) {
+		data = append([]byte{0x00}, key.PrivateKey[:]...)
+	} else {
+		data = key.PublicKey()
+	}
+
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	child := Key{Curve: key.Curve, Depth: key.Depth + 1, ParentFingerprint: key.Fingerprint(), ChildNumber: index}
+
+	for {
+		mac := hmac.New(SHA512.New, key.ChainCode[:])
+		mac.Write(data)
+		digest := mac.Sum(nil)
+
+		privateKey, ok := key.Curve.childPrivateKey(key.PrivateKey[:], digest[:KeyLength])
+
+		if (ok) {
+			child.PrivateKey = privateKey
+			copy(child.ChainCode[:], digest[KeyLength:])
+			break
+		}
+
+		// Invalid keys are skipped by deriving from 0x01 || right half || index
+		data = binary.BigEndian.AppendUint32(append([]byte{0x01}, digest[KeyLength:]...), index)
+	}
+
+	return child, nil
+}
+
+// Derive the key at a path such as "m/44'/501'/0'/0'", relative
+// to this key. An error is returned if the path cannot be parsed or
+// any derivation step fails.
+func (key Key) DerivePath(path string) (Key, error) {
+	indices, err := ParsePath(path)
+
+	if (err != nil) { return Key{}, err }
+
+	for _, index := range indices {
+		key, err = key.Derive(index)
+
+		if (err != nil) { return Key{}, err }
+	}
+
+	return key, nil
+}
+
+// Public key for this key's private key, serialized as detailed
+// by the spec: 0x00 followed by the Ed25519 public key, or the
+// 33 byte compressed secp256k1 point.
+func (key Key) PublicKey() []byte {
+	if (key.Curve == Secp256k1) {
+		return secp256k1.PrivKeyFromBytes(key.PrivateKey[:]).PubKey().SerializeCompressed()
+	}
+
+	return append([]byte{0x00}, key.rawPublicKey()...)
+}
+
+// Helper method to get the Ed25519 public key without the SLIP-0010 prefix.
+func (key Key) rawPublicKey() []byte {
+	return ed25519.NewKeyFromSeed(key.PrivateKey[:]).Public().(ed25519.PublicKey)
+}
+
+// Parse a derivation path of the form "m/a/b'/c" into child indices.
+// Hardened indices may be marked with ', h or H.
+// An error is returned if the path does not begin with "m" or an
+// index is not a number below 2^31.
+func ParsePath(path string) ([]uint32, error) {
+	segments := strings.Split(path, "/")
+
+	if (segments[0] != "m") {
+		return []uint32{}, slip10Error{Message: "Derivation path must begin with 'm'."}
+	}
+
+	indices := make([]uint32, len(segments) - 1)
+
+	for i, segment := range segments[1:] {
+		var offset uint32
+
+		if (strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") || strings.HasSuffix(segment, "H")) {
+			segment = segment[:len(segment) - 1]
+			offset = HardenedOffset
+		}
+
+		index, err := strconv.ParseUint(segment, 10, 31)
+
+		if (err != nil) {
+			return []uint32{}, slip10Error{Message: "Invalid derivation path index '" + segment + "'."}
+		}
+
+		indices[i] = uint32(index) + offset
+	}
+
+	return indices, nil
+}
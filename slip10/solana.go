@@ -0,0 +1,48 @@
+package slip10
+
+// This file derives Solana accounts from a binary seed using the
+// path m/44'/501'/account'/0' used by Solana wallets.
+
+import (
+	"crypto/ed25519"
+	"gobip39/base58"
+)
+
+const (
+	SolanaCoinType uint32 = 501
+)
+
+// Derive the Solana key for an account at m/44'/501'/account'/0'.
+// An error is returned if the seed is invalid or account is not
+// below HardenedOffset.
+func DeriveSolanaKey(seed []byte, account uint32) (Key, error) {
+	if (account >= HardenedOffset) {
+		return Key{}, slip10Error{Message: "Account index must be below 2^31."}
+	}
+
+	master, err := NewMasterKey(seed, Ed25519)
+
+	if (err != nil) { return Key{}, err }
+
+	path := []uint32{44, SolanaCoinType, account, 0}
+
+	for _, index := range path {
+		master, err = master.Derive(index + HardenedOffset)
+
+		if (err != nil) { return Key{}, err }
+	}
+
+	return master, nil
+}
+
+// Solana address of the key, which is the Base58 encoding
+// of the raw Ed25519 public key.
+func (key Key) SolanaAddress() string {
+	return base58.Encode(key.rawPublicKey())
+}
+
+// Solana keypair of the key as used by the Solana CLI: the
+// 32 byte private key followed by the 32 byte public key.
+func (key Key) SolanaKeypair() []byte {
+	return ed25519.NewKeyFromSeed(key.PrivateKey[:])
+}
@@ -0,0 +1,77 @@
+package slip10
+
+// This file derives Stellar accounts from a binary seed as detailed by
+// SEP-0005 spec: https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0005.md
+
+import (
+	"encoding/base32"
+)
+
+const (
+	StellarCoinType uint32 = 148
+	stellarAccountVersion byte = 6 << 3
+	stellarSeedVersion byte = 18 << 3
+)
+
+// Derive the Stellar key for an account at m/44'/148'/account'.
+// An error is returned if the seed is invalid or account is not
+// below HardenedOffset.
+func DeriveStellarKey(seed []byte, account uint32) (Key, error) {
+	if (account >= HardenedOffset) {
+		return Key{}, slip10Error{Message: "Account index must be below 2^31."}
+	}
+
+	master, err := NewMasterKey(seed, Ed25519)
+
+	if (err != nil) { return Key{}, err }
+
+	path := []uint32{44, StellarCoinType, account}
+
+	for _, index := range path {
+		master, err = master.Derive(index + HardenedOffset)
+
+		if (err != nil) { return Key{}, err }
+	}
+
+	return master, nil
+}
+
+// Stellar account ID of the key in strkey format ("G...").
+func (key Key) StellarAddress() string {
+	return encodeStrkey(stellarAccountVersion, key.rawPublicKey())
+}
+
+// Stellar secret seed of the key in strkey format ("S...").
+func (key Key) StellarSecret() string {
+	return encodeStrkey(stellarSeedVersion, key.PrivateKey[:])
+}
+
+// Helper method to encode a payload as a strkey: the version byte and
+// payload followed by their CRC16-XModem checksum (little endian),
+// all in unpadded Base32.
+func encodeStrkey(version byte, payload []byte) string {
+	data := append([]byte{version}, payload...)
+	checksum := crc16XModem(data)
+	data = append(data, byte(checksum), byte(checksum >> 8))
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data)
+}
+
+// CRC16-XModem checksum (polynomial 0x1021, initial value 0).
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+
+		for i := 0; i < 8; i++ {
+			if (crc & 0x8000 != 0) {
+				crc = crc << 1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
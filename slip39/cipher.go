@@ -0,0 +1,74 @@
+package slip39
+
+// This file implements the passphrase encryption of the master secret,
+// a 4 round Feistel network keyed with PBKDF2-HMAC-SHA256, as detailed by
+// SLIP-0039 spec.
+
+import (
+	SHA256 "crypto/sha256"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	BaseIterationCount = 10000
+	RoundCount = 4
+)
+
+// Encrypt the master secret with a passphrase. identifier and
+// extendable select the salt, iterationExponent the PBKDF2 cost.
+func encrypt(masterSecret []byte, passphrase []byte, iterationExponent byte, identifier uint16, extendable bool) []byte {
+	half := len(masterSecret) / 2
+	left := append([]byte{}, masterSecret[:half]...)
+	right := append([]byte{}, masterSecret[half:]...)
+	salt := cipherSalt(identifier, extendable)
+
+	for i := 0; i < RoundCount; i++ {
+		left, right = right, xor(left, roundFunction(byte(i), passphrase, iterationExponent, salt, right))
+	}
+
+	return append(right, left...)
+}
+
+// Decrypt the encrypted master secret with a passphrase, reversing encrypt.
+func decrypt(encryptedSecret []byte, passphrase []byte, iterationExponent byte, identifier uint16, extendable bool) []byte {
+	half := len(encryptedSecret) / 2
+	left := append([]byte{}, encryptedSecret[:half]...)
+	right := append([]byte{}, encryptedSecret[half:]...)
+	salt := cipherSalt(identifier, extendable)
+
+	for i := RoundCount - 1; i >= 0; i-- {
+		left, right = right, xor(left, roundFunction(byte(i), passphrase, iterationExponent, salt, right))
+	}
+
+	return append(right, left...)
+}
+
+// Helper method for the Feistel round function: PBKDF2 over the round
+// number and passphrase, salted with the salt prefix and right half.
+func roundFunction(round byte, passphrase []byte, iterationExponent byte, salt []byte, right []byte) []byte {
+	iterations := (BaseIterationCount << iterationExponent) / RoundCount
+	password := append([]byte{round}, passphrase...)
+
+	return pbkdf2.Key(password, append(salt[:len(salt):len(salt)], right...), iterations, len(right), SHA256.New)
+}
+
+// Helper method to get the salt prefix. Non-extendable shares salt
+// with "shamir" followed by the identifier, extendable shares do not.
+func cipherSalt(identifier uint16, extendable bool) []byte {
+	if (extendable) {
+		return []byte{}
+	}
+
+	return append([]byte(customizationString), byte(identifier >> 8), byte(identifier))
+}
+
+// Helper method to XOR two equal length byte slices.
+func xor(a []byte, b []byte) []byte {
+	result := make([]byte, len(a))
+
+	for i := range a {
+		result[i] = a[i] ^ b[i]
+	}
+
+	return result
+}
@@ -0,0 +1,69 @@
+package slip39
+
+// This file implements the RS1024 checksum, a Reed-Solomon code over
+// GF(1024) which protects each share's words as detailed by
+// SLIP-0039 spec.
+
+const (
+	ChecksumLengthWords = 3
+	customizationString = "shamir"
+	extendableCustomizationString = "shamir_extendable"
+)
+
+var rs1024Generator = [10]uint32{
+	0xE0E040, 0x1C1C080, 0x3838100, 0x7070200, 0xE0E0009,
+	0x1C0C2412, 0x38086C24, 0x3090FC48, 0x21B1F890, 0x3F3F120,
+}
+
+// Helper method to compute the RS1024 polymod over 10-bit values.
+func rs1024Polymod(values []int) uint32 {
+	checksum := uint32(1)
+
+	for _, value := range values {
+		top := checksum >> 20
+		checksum = (checksum & 0xFFFFF) << 10 ^ uint32(value)
+
+		for i := uint(0); i < 10; i++ {
+			if (top >> i & 1 == 1) {
+				checksum ^= rs1024Generator[i]
+			}
+		}
+	}
+
+	return checksum
+}
+
+// Helper method to get the customization string values which
+// prefix the checksummed data.
+func customization(extendable bool) []int {
+	str := customizationString
+	if (extendable) {
+		str = extendableCustomizationString
+	}
+
+	values := make([]int, len(str))
+	for i := 0; i < len(str); i++ {
+		values[i] = int(str[i])
+	}
+
+	return values
+}
+
+// Create the 3 checksum words for data.
+func rs1024CreateChecksum(data []int, extendable bool) []int {
+	values := append(customization(extendable), data...)
+	values = append(values, make([]int, ChecksumLengthWords)...)
+	polymod := rs1024Polymod(values) ^ 1
+
+	checksum := make([]int, ChecksumLengthWords)
+	for i := 0; i < ChecksumLengthWords; i++ {
+		checksum[i] = int(polymod >> uint(RadixBits * (ChecksumLengthWords - 1 - i)) & 1023)
+	}
+
+	return checksum
+}
+
+// Verify data followed by its 3 checksum words.
+func rs1024VerifyChecksum(data []int, extendable bool) bool {
+	return rs1024Polymod(append(customization(extendable), data...)) == 1
+}
@@ -0,0 +1,433 @@
+package slip39
+
+// This file wraps Shamir mnemonic shares as detailed by
+// SLIP-0039 spec: https://github.com/satoshilabs/slips/blob/master/slip-0039.md
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"strings"
+	"gobip39"
+	"gobip39/shamir"
+	"gobip39/wordlist"
+)
+
+const (
+	IdentifierBits = 15
+	IterationExponentBits = 4
+	MaximumIterationExponent = 1 << IterationExponentBits - 1
+	MinimumSecretSize = 128
+	MaximumShareCount = 16
+	MetadataLengthWords = 7
+	MinimumMnemonicLengthWords = MetadataLengthWords + (MinimumSecretSize + RadixBits - 1) / RadixBits
+)
+
+// Error type specifically for SLIP-0039 errors
+type slip39Error struct {
+	Message string
+}
+
+func (err slip39Error) Error() string {
+	return err.Message
+}
+
+// Type to wrap a single share and the parameters of the set it belongs to.
+// Thresholds and counts are stored as their actual values (1 to 16),
+// not the offset values encoded in the mnemonic.
+type Share struct {
+	Identifier uint16
+	Extendable bool
+	IterationExponent byte
+	GroupIndex byte
+	GroupThreshold byte
+	GroupCount byte
+	MemberIndex byte
+	MemberThreshold byte
+	Value []byte
+}
+
+// Threshold and count of the member shares within one group.
+type Group struct {
+	Threshold byte
+	Count byte
+}
+
+// Split a master secret into groups of shares, encrypting it with an optional
+// passphrase first. Any groupThreshold groups, each with at least its member
+// threshold of shares, recover the secret. Extendable shares allow more share
+// sets with the same identifier to be created later.
+// An error is returned if the secret's size or the group parameters
+// are invalid, or the system's randomness source fails.
+func GenerateShares(masterSecret []byte, passphrase string, groupThreshold byte, groups []Group, extendable bool, iterationExponent byte) ([][]Share, error) {
+	if (len(masterSecret) * 8 < MinimumSecretSize || len(masterSecret) % 2 != 0) {
+		return [][]Share{}, slip39Error{Message: "Length of master secret (in bits) must be at least 128 and a multiple of 16."}
+	}
+
+	if (!isPrintableASCII(passphrase)) {
+		return [][]Share{}, slip39Error{Message: "Passphrase must only contain printable ASCII characters."}
+	}
+
+	if (iterationExponent > MaximumIterationExponent) {
+		return [][]Share{}, slip39Error{Message: "Iteration exponent must not exceed 15."}
+	}
+
+	if (len(groups) == 0 || len(groups) > MaximumShareCount || groupThreshold < 1 || int(groupThreshold) > len(groups)) {
+		return [][]Share{}, slip39Error{Message: "Group threshold must be between 1 and the group count, which must not exceed 16."}
+	}
+
+	for _, group := range groups {
+		if (group.Threshold == 1 && group.Count > 1) {
+			return [][]Share{}, slip39Error{Message: "Creating multiple member shares with member threshold 1 is not allowed. Use 1-of-1 member sharing instead."}
+		}
+	}
+
+	var identifierBytes [2]byte
+
+	if _, err := rand.Read(identifierBytes[:]); err != nil {
+		return [][]Share{}, slip39Error{Message: err.Error()}
+	}
+
+	identifier := binary.BigEndian.Uint16(identifierBytes[:]) & (1 << IdentifierBits - 1)
+
+	encryptedSecret := encrypt(masterSecret, []byte(passphrase), iterationExponent, identifier, extendable)
+
+	groupSecrets, err := shamir.SplitSecret(int(groupThreshold), len(groups), encryptedSecret)
+
+	if (err != nil) { return [][]Share{}, err }
+
+	shares := make([][]Share, len(groups))
+
+	for i, group := range groups {
+		if (group.Threshold < 1 || group.Threshold > group.Count || group.Count > MaximumShareCount) {
+			return [][]Share{}, slip39Error{Message: "Member threshold must be between 1 and the member count, which must not exceed 16."}
+		}
+
+		memberSecrets, memberErr := shamir.SplitSecret(int(group.Threshold), int(group.Count), groupSecrets[i].Data)
+
+		if (memberErr != nil) { return [][]Share{}, memberErr }
+
+		shares[i] = make([]Share, len(memberSecrets))
+
+		for j, member := range memberSecrets {
+			shares[i][j] = Share{
+				Identifier: identifier,
+				Extendable: extendable,
+				IterationExponent: iterationExponent,
+				GroupIndex: groupSecrets[i].X,
+				GroupThreshold: groupThreshold,
+				GroupCount: byte(len(groups)),
+				MemberIndex: member.X,
+				MemberThreshold: group.Threshold,
+				Value: member.Data,
+			}
+		}
+	}
+
+	return shares, nil
+}
+
+// Helper method that splits an Entropy's data with GenerateShares, so an
+// existing BIP-0039 backup can be moved to SLIP-0039 shares. The shares
+// hold the entropy itself, not the BIP-0039 seed, so the same wallet
+// is recovered through RecoverEntropy.
+// An error is returned if the Entropy's data is not a valid SLIP-0039
+// master secret or splitting fails.
+func SplitEntropy(ent gobip39.Entropy, passphrase string, groupThreshold byte, groups []Group, extendable bool, iterationExponent byte) ([][]Share, error) {
+	return GenerateShares(ent.Data, passphrase, groupThreshold, groups, extendable, iterationExponent)
+}
+
+// Recover the master secret from shares, decrypting it with the passphrase.
+// An error is returned if the shares do not belong to the same set, there
+// are not enough groups or members to meet the thresholds, or a share is
+// invalid.
+func Combine(shares []Share, passphrase string) ([]byte, error) {
+	if (len(shares) == 0) {
+		return []byte{}, slip39Error{Message: "No shares were provided."}
+	}
+
+	if (!isPrintableASCII(passphrase)) {
+		return []byte{}, slip39Error{Message: "Passphrase must only contain printable ASCII characters."}
+	}
+
+	first := shares[0]
+
+	// Sort member shares into their groups
+	groups := map[byte][]Share{}
+	for _, share := range shares {
+		if (share.Identifier != first.Identifier || share.Extendable != first.Extendable || share.IterationExponent != first.IterationExponent) {
+			return []byte{}, slip39Error{Message: "All shares must have the same identifier and iteration exponent."}
+		}
+
+		if (share.GroupThreshold != first.GroupThreshold || share.GroupCount != first.GroupCount) {
+			return []byte{}, slip39Error{Message: "All shares must have the same group threshold and group count."}
+		}
+
+		if (len(share.Value) != len(first.Value)) {
+			return []byte{}, slip39Error{Message: "All share values must have the same length."}
+		}
+
+		members := groups[share.GroupIndex]
+		if (len(members) > 0 && members[0].MemberThreshold != share.MemberThreshold) {
+			return []byte{}, slip39Error{Message: "Shares within a group must have the same member threshold."}
+		}
+
+		groups[share.GroupIndex] = append(members, share)
+	}
+
+	if (len(groups) < int(first.GroupThreshold)) {
+		return []byte{}, slip39Error{Message: "Insufficient number of share groups to meet the group threshold."}
+	}
+
+	if (len(groups) != int(first.GroupThreshold)) {
+		return []byte{}, slip39Error{Message: "Wrong number of share groups; expected exactly the group threshold."}
+	}
+
+	groupPoints := make([]shamir.Point, 0, len(groups))
+
+	for index, members := range groups {
+		if (len(members) != int(members[0].MemberThreshold)) {
+			return []byte{}, slip39Error{Message: "Wrong number of shares in a group; expected exactly the member threshold."}
+		}
+
+		memberPoints := make([]shamir.Point, len(members))
+		for i, member := range members {
+			memberPoints[i] = shamir.Point{X: member.MemberIndex, Data: member.Value}
+		}
+
+		groupSecret, err := shamir.RecoverSecret(int(members[0].MemberThreshold), memberPoints)
+
+		if (err != nil) { return []byte{}, err }
+
+		groupPoints = append(groupPoints, shamir.Point{X: index, Data: groupSecret})
+	}
+
+	encryptedSecret, err := shamir.RecoverSecret(int(first.GroupThreshold), groupPoints)
+
+	if (err != nil) { return []byte{}, err }
+
+	return decrypt(encryptedSecret, []byte(passphrase), first.IterationExponent, first.Identifier, first.Extendable), nil
+}
+
+// Helper method that parses each mnemonic with ParseShare and then
+// calls Combine.
+// An error is returned if a mnemonic is invalid or combining fails.
+func CombineMnemonics(mnemonics [][]string, passphrase string) ([]byte, error) {
+	shares := make([]Share, len(mnemonics))
+
+	for i, mnemonic := range mnemonics {
+		var err error
+		shares[i], err = ParseShare(mnemonic)
+
+		if (err != nil) { return []byte{}, err }
+	}
+
+	return Combine(shares, passphrase)
+}
+
+// Helper method that combines shares and then calls GetEntropyFromBytes,
+// reversing SplitEntropy.
+// An error is returned if combining fails or the master secret is
+// not a valid BIP-0039 Entropy size.
+func RecoverEntropy(shares []Share, passphrase string) (gobip39.Entropy, error) {
+	secret, err := Combine(shares, passphrase)
+
+	if (err != nil) { return gobip39.Entropy{}, err }
+
+	return gobip39.GetEntropyFromBytes(secret)
+}
+
+// Encode the share as SLIP-0039 mnemonic words.
+// An error is returned if a field is out of range or reading the
+// wordlist fails.
+func (share Share) Words() ([]string, error) {
+	if (share.Identifier >= 1 << IdentifierBits || share.IterationExponent > MaximumIterationExponent) {
+		return []string{}, slip39Error{Message: "Identifier or iteration exponent is out of range."}
+	}
+
+	if (share.GroupIndex >= MaximumShareCount || share.MemberIndex >= MaximumShareCount) {
+		return []string{}, slip39Error{Message: "Group and member indices must be below 16."}
+	}
+
+	if (!inThresholdRange(share.GroupThreshold) || !inThresholdRange(share.GroupCount) || !inThresholdRange(share.MemberThreshold)) {
+		return []string{}, slip39Error{Message: "Thresholds and counts must be between 1 and 16."}
+	}
+
+	if (len(share.Value) * 8 < MinimumSecretSize || len(share.Value) % 2 != 0) {
+		return []string{}, slip39Error{Message: "Length of share value (in bits) must be at least 128 and a multiple of 16."}
+	}
+
+	// Identifier, extendable flag and iteration exponent fill the first two words
+	var extendable uint32
+	if (share.Extendable) {
+		extendable = 1
+	}
+
+	idExponent := uint32(share.Identifier) << (IterationExponentBits + 1) | extendable << IterationExponentBits | uint32(share.IterationExponent)
+
+	// Group and member parameters fill the next two words
+	parameters := uint32(share.GroupIndex) << 16 | uint32(share.GroupThreshold - 1) << 12 | uint32(share.GroupCount - 1) << 8 | uint32(share.MemberIndex) << 4 | uint32(share.MemberThreshold - 1)
+
+	indices := []int{int(idExponent >> RadixBits), int(idExponent & 1023), int(parameters >> RadixBits), int(parameters & 1023)}
+	indices = append(indices, bytesToIndices(share.Value)...)
+	indices = append(indices, rs1024CreateChecksum(indices, share.Extendable)...)
+
+	words, err := Words()
+
+	if (err != nil) { return []string{}, err }
+
+	mnemonic := make([]string, len(indices))
+	for i, index := range indices {
+		mnemonic[i] = words[index]
+	}
+
+	return mnemonic, nil
+}
+
+// Parse SLIP-0039 mnemonic words into a Share. Words may be given in
+// full or by a prefix of at least four letters, which is always unique.
+// An error is returned if a word is not in the wordlist, the mnemonic
+// is too short, its checksum is invalid or its padding is invalid.
+func ParseShare(mnemonic []string) (Share, error) {
+	if (len(mnemonic) < MinimumMnemonicLengthWords) {
+		return Share{}, slip39Error{Message: "Mnemonic is too short."}
+	}
+
+	words, err := Words()
+
+	if (err != nil) { return Share{}, err }
+
+	indices := make([]int, len(mnemonic))
+
+	for i, word := range mnemonic {
+		indices[i] = wordlist.FindWordIn(words[:], strings.ToLower(word))
+
+		// Fall back to a prefix of at least four letters
+		if (indices[i] < 0 && len(word) >= 4) {
+			indices[i] = findPrefix(&words, strings.ToLower(word))
+		}
+
+		if (indices[i] < 0) {
+			return Share{}, slip39Error{Message: "Invalid mnemonic word '" + word + "'."}
+		}
+	}
+
+	idExponent := indices[0] << RadixBits | indices[1]
+	extendable := idExponent >> IterationExponentBits & 1 == 1
+
+	if (!rs1024VerifyChecksum(indices, extendable)) {
+		return Share{}, slip39Error{Message: "Invalid mnemonic checksum."}
+	}
+
+	parameters := indices[2] << RadixBits | indices[3]
+
+	share := Share{
+		Identifier: uint16(idExponent >> (IterationExponentBits + 1)),
+		Extendable: extendable,
+		IterationExponent: byte(idExponent & MaximumIterationExponent),
+		GroupIndex: byte(parameters >> 16),
+		GroupThreshold: byte(parameters >> 12 & 15) + 1,
+		GroupCount: byte(parameters >> 8 & 15) + 1,
+		MemberIndex: byte(parameters >> 4 & 15),
+		MemberThreshold: byte(parameters & 15) + 1,
+	}
+
+	if (share.GroupCount < share.GroupThreshold) {
+		return Share{}, slip39Error{Message: "Group threshold cannot be greater than group count."}
+	}
+
+	share.Value, err = indicesToBytes(indices[4:len(indices) - ChecksumLengthWords])
+
+	if (err != nil) { return Share{}, err }
+
+	return share, nil
+}
+
+// Helper method to convert bytes into 10-bit word indices, padding
+// the most significant bits with zeros.
+func bytesToIndices(data []byte) []int {
+	bitCount := len(data) * 8
+	wordCount := (bitCount + RadixBits - 1) / RadixBits
+	padding := wordCount * RadixBits - bitCount
+
+	indices := make([]int, wordCount)
+
+	for i := 0; i < wordCount; i++ {
+		value := 0
+
+		for j := 0; j < RadixBits; j++ {
+			// Bit position within the data, ignoring the padding bits
+			bit := i * RadixBits + j - padding
+
+			value <<= 1
+			if (bit >= 0 && data[bit / 8] >> uint(7 - bit % 8) & 1 == 1) {
+				value |= 1
+			}
+		}
+
+		indices[i] = value
+	}
+
+	return indices
+}
+
+// Helper method to convert 10-bit word indices back into bytes.
+// An error is returned if the padding is longer than 8 bits or non-zero.
+func indicesToBytes(indices []int) ([]byte, error) {
+	padding := RadixBits * len(indices) % 16
+
+	if (padding > 8) {
+		return []byte{}, slip39Error{Message: "Invalid mnemonic length."}
+	}
+
+	data := make([]byte, (RadixBits * len(indices) - padding) / 8)
+
+	for i, index := range indices {
+		for j := 0; j < RadixBits; j++ {
+			bit := i * RadixBits + j - padding
+			isSet := index >> uint(RadixBits - 1 - j) & 1 == 1
+
+			if (bit < 0) {
+				if (isSet) {
+					return []byte{}, slip39Error{Message: "Invalid mnemonic padding."}
+				}
+
+				continue
+			}
+
+			if (isSet) {
+				data[bit / 8] |= 1 << uint(7 - bit % 8)
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// Helper method to find the word starting with the given prefix,
+// returning -1 if there is none.
+func findPrefix(words *[WordlistSize]string, prefix string) int {
+	for i, word := range words {
+		if (strings.HasPrefix(word, prefix)) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Helper method to check a threshold or count is within [1, 16].
+func inThresholdRange(value byte) bool {
+	return value >= 1 && value <= MaximumShareCount
+}
+
+// Helper method to check the passphrase only holds printable ASCII,
+// as required by the spec.
+func isPrintableASCII(passphrase string) bool {
+	for i := 0; i < len(passphrase); i++ {
+		if (passphrase[i] < 32 || passphrase[i] > 126) {
+			return false
+		}
+	}
+
+	return true
+}
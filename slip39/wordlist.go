@@ -0,0 +1,68 @@
+package slip39
+
+// This file contains the SLIP-0039 Wordlist. Unlike BIP-0039's
+// 2048 word lists, SLIP-0039 uses 1024 words so each word holds
+// 10 bits, and every word is identified by its first four letters.
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+)
+
+const (
+	WordlistSize = 1024
+	RadixBits = 10
+)
+
+// Helper method to get the location to the current directory
+func getCurrentDirectory() (string, error) {
+	// Get the path to the current file
+	_, filepath, _, ok := runtime.Caller(0)
+
+	if (!ok) {
+		return "", errors.New("Could not get the path to the current directory.")
+	}
+
+	return path.Dir(filepath), nil
+}
+
+// Read all words of the SLIP-0039 Wordlist.
+// An error is returned if reading the wordlist's file fails, in
+// which case the array will have no contents.
+func Words() ([WordlistSize]string, error) {
+	directory, directoryErr := getCurrentDirectory()
+
+	if (directoryErr != nil) {
+		return [WordlistSize]string{}, slip39Error{Message: directoryErr.Error()}
+	}
+
+	wordlistFile, err := os.Open(path.Join(directory, "wordlist.txt"))
+
+	if (err != nil) {
+		return [WordlistSize]string{}, slip39Error{Message: err.Error()}
+	}
+
+	defer wordlistFile.Close()
+
+	reader := bufio.NewReader(wordlistFile)
+
+	var words [WordlistSize]string
+
+	for i := 0; i < WordlistSize; i++ {
+		word, readErr := reader.ReadString('\n')
+
+		if (readErr != nil) {
+			return [WordlistSize]string{}, slip39Error{Message: readErr.Error()}
+		}
+
+		// Remove the newline and a possible \r because Windows
+		words[i] = strings.Replace(word[:len(word) - 1], "\r", "", -1)
+	}
+
+	return words, nil
+}
+
@@ -0,0 +1,87 @@
+package sskr
+
+// This file encodes shares as CBOR, wrapped either as a "ur:sskr" Uniform
+// Resource or as standard Bytewords for writing down.
+
+import (
+	"gobip39/bytewords"
+	"gobip39/cbor"
+	"gobip39/ur"
+)
+
+const (
+	URType = "sskr"
+	Tag uint64 = 40309
+	// Type and tag used before the registry dropped the "crypto-" prefix
+	LegacyURType = "crypto-sskr"
+	LegacyTag uint64 = 309
+)
+
+// Encode the share as a "ur:sskr" Uniform Resource.
+func (share Share) UR() (string, error) {
+	return ur.UR{Type: URType, CBOR: cbor.EncodeBytes(share.Bytes())}.String()
+}
+
+// Encode the share as standard Bytewords of its tagged CBOR.
+func (share Share) Bytewords() string {
+	return bytewords.Encode(cbor.EncodeTag(Tag, cbor.EncodeBytes(share.Bytes())), bytewords.Standard)
+}
+
+// Parse a share from a "ur:sskr" (or legacy "ur:crypto-sskr") Uniform Resource.
+// An error is returned if the UR is invalid, has another type, or does
+// not hold a valid share.
+func ParseUR(encoded string) (Share, error) {
+	resource, err := ur.Parse(encoded)
+
+	if (err != nil) { return Share{}, err }
+
+	if (resource.Type != URType && resource.Type != LegacyURType) {
+		return Share{}, sskrError{Message: "Expected a UR of type '" + URType + "', got '" + resource.Type + "'."}
+	}
+
+	decoder := cbor.NewDecoder(resource.CBOR)
+
+	// The tag is optional inside a UR, where the type already identifies the body
+	if major, _ := decoder.PeekMajor(); major == cbor.Tag {
+		if tag, _ := decoder.ReadTag(); tag != Tag && tag != LegacyTag {
+			return Share{}, sskrError{Message: "Unexpected CBOR tag for an SSKR share."}
+		}
+	}
+
+	return decodeShare(decoder)
+}
+
+// Parse a share from standard Bytewords of its tagged CBOR.
+// An error is returned if the Bytewords are invalid or do not hold a
+// valid tagged share.
+func ParseBytewords(encoded string) (Share, error) {
+	data, err := bytewords.Decode(encoded, bytewords.Standard)
+
+	if (err != nil) { return Share{}, err }
+
+	decoder := cbor.NewDecoder(data)
+
+	tag, tagErr := decoder.ReadTag()
+
+	if (tagErr != nil) { return Share{}, tagErr }
+
+	if (tag != Tag && tag != LegacyTag) {
+		return Share{}, sskrError{Message: "Unexpected CBOR tag for an SSKR share."}
+	}
+
+	return decodeShare(decoder)
+}
+
+// Helper method to read the share's byte string, which must be the
+// last CBOR item.
+func decodeShare(decoder *cbor.Decoder) (Share, error) {
+	data, err := decoder.ReadBytes()
+
+	if (err != nil) { return Share{}, err }
+
+	if (!decoder.Done()) {
+		return Share{}, sskrError{Message: "Unexpected data after the SSKR share."}
+	}
+
+	return ParseShare(data)
+}
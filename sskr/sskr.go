@@ -0,0 +1,245 @@
+package sskr
+
+// This file implements Sharded Secret Key Reconstruction as detailed by
+// BCR-2020-011 spec: https://github.com/BlockchainCommons/Research/blob/master/papers/bcr-2020-011-sskr.md
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"gobip39"
+	"gobip39/shamir"
+)
+
+const (
+	MinimumSecretLength = 16
+	MaximumSecretLength = 32
+	MaximumShareCount = 16
+	MetadataLength = 5
+)
+
+// Error type specifically for SSKR errors
+type sskrError struct {
+	Message string
+}
+
+func (err sskrError) Error() string {
+	return err.Message
+}
+
+// Type to wrap a single share and the parameters of the set it belongs to.
+// Thresholds and counts are stored as their actual values (1 to 16),
+// not the offset values encoded in the share.
+type Share struct {
+	Identifier uint16
+	GroupIndex byte
+	GroupThreshold byte
+	GroupCount byte
+	MemberIndex byte
+	MemberThreshold byte
+	Value []byte
+}
+
+// Threshold and count of the member shares within one group.
+type Group struct {
+	Threshold byte
+	Count byte
+}
+
+// Split Entropy into groups of shares. Any groupThreshold groups, each
+// with at least its member threshold of shares, recover the Entropy.
+// An error is returned if the Entropy is invalid, the group parameters
+// are out of range, or the system's randomness source fails.
+func Generate(ent gobip39.Entropy, groupThreshold byte, groups []Group) ([][]Share, error) {
+	if _, err := gobip39.GetEntropyFromBytes(ent.Data); err != nil {
+		return [][]Share{}, sskrError{Message: err.Error()}
+	}
+
+	return GenerateFromSecret(ent.Data, groupThreshold, groups)
+}
+
+// Split a secret of 16 to 32 bytes (of even length) into groups of shares.
+// An error is returned if the secret's length or the group parameters
+// are invalid, or the system's randomness source fails.
+func GenerateFromSecret(secret []byte, groupThreshold byte, groups []Group) ([][]Share, error) {
+	if (len(secret) < MinimumSecretLength || len(secret) > MaximumSecretLength || len(secret) % 2 != 0) {
+		return [][]Share{}, sskrError{Message: "Length of secret (in bytes) must be even and within domain [16, 32]."}
+	}
+
+	if (len(groups) == 0 || len(groups) > MaximumShareCount || groupThreshold < 1 || int(groupThreshold) > len(groups)) {
+		return [][]Share{}, sskrError{Message: "Group threshold must be between 1 and the group count, which must not exceed 16."}
+	}
+
+	for _, group := range groups {
+		if (group.Threshold < 1 || group.Threshold > group.Count || group.Count > MaximumShareCount) {
+			return [][]Share{}, sskrError{Message: "Member threshold must be between 1 and the member count, which must not exceed 16."}
+		}
+	}
+
+	var identifierBytes [2]byte
+
+	if _, err := rand.Read(identifierBytes[:]); err != nil {
+		return [][]Share{}, sskrError{Message: err.Error()}
+	}
+
+	identifier := binary.BigEndian.Uint16(identifierBytes[:])
+
+	groupSecrets, err := shamir.SplitSecret(int(groupThreshold), len(groups), secret)
+
+	if (err != nil) { return [][]Share{}, err }
+
+	shares := make([][]Share, len(groups))
+
+	for i, group := range groups {
+		memberSecrets, memberErr := shamir.SplitSecret(int(group.Threshold), int(group.Count), groupSecrets[i].Data)
+
+		if (memberErr != nil) { return [][]Share{}, memberErr }
+
+		shares[i] = make([]Share, len(memberSecrets))
+
+		for j, member := range memberSecrets {
+			shares[i][j] = Share{
+				Identifier: identifier,
+				GroupIndex: groupSecrets[i].X,
+				GroupThreshold: groupThreshold,
+				GroupCount: byte(len(groups)),
+				MemberIndex: member.X,
+				MemberThreshold: group.Threshold,
+				Value: member.Data,
+			}
+		}
+	}
+
+	return shares, nil
+}
+
+// Recover the secret from shares. Only the first member threshold shares
+// of a group and the first group threshold complete groups are used.
+// An error is returned if the shares do not belong to the same set,
+// there are not enough groups or members to meet the thresholds, or
+// a share is invalid.
+func CombineSecret(shares []Share) ([]byte, error) {
+	if (len(shares) == 0) {
+		return []byte{}, sskrError{Message: "No shares were provided."}
+	}
+
+	first := shares[0]
+
+	// Sort member shares into their groups, keeping the groups' order
+	groupOrder := []byte{}
+	groups := map[byte][]shamir.Point{}
+	memberThresholds := map[byte]byte{}
+
+	for _, share := range shares {
+		if (share.Identifier != first.Identifier || share.GroupThreshold != first.GroupThreshold || share.GroupCount != first.GroupCount) {
+			return []byte{}, sskrError{Message: "All shares must have the same identifier, group threshold and group count."}
+		}
+
+		if (len(share.Value) != len(first.Value)) {
+			return []byte{}, sskrError{Message: "All share values must have the same length."}
+		}
+
+		threshold, seen := memberThresholds[share.GroupIndex]
+
+		if (!seen) {
+			groupOrder = append(groupOrder, share.GroupIndex)
+			memberThresholds[share.GroupIndex] = share.MemberThreshold
+		} else if (threshold != share.MemberThreshold) {
+			return []byte{}, sskrError{Message: "Shares within a group must have the same member threshold."}
+		}
+
+		for _, member := range groups[share.GroupIndex] {
+			if (member.X == share.MemberIndex) {
+				return []byte{}, sskrError{Message: "Duplicate member share."}
+			}
+		}
+
+		groups[share.GroupIndex] = append(groups[share.GroupIndex], shamir.Point{X: share.MemberIndex, Data: share.Value})
+	}
+
+	groupPoints := []shamir.Point{}
+
+	for _, index := range groupOrder {
+		members := groups[index]
+		threshold := int(memberThresholds[index])
+
+		if (len(members) < threshold || len(groupPoints) == int(first.GroupThreshold)) {
+			continue
+		}
+
+		groupSecret, err := shamir.RecoverSecret(threshold, members[:threshold])
+
+		if (err != nil) { return []byte{}, err }
+
+		groupPoints = append(groupPoints, shamir.Point{X: index, Data: groupSecret})
+	}
+
+	if (len(groupPoints) < int(first.GroupThreshold)) {
+		return []byte{}, sskrError{Message: "Insufficient number of complete groups to meet the group threshold."}
+	}
+
+	return shamir.RecoverSecret(int(first.GroupThreshold), groupPoints)
+}
+
+// Helper method that recovers the secret with CombineSecret and then
+// calls GetEntropyFromBytes.
+// An error is returned if combining fails or the secret is not a
+// valid Entropy size.
+func Combine(shares []Share) (gobip39.Entropy, error) {
+	secret, err := CombineSecret(shares)
+
+	if (err != nil) { return gobip39.Entropy{}, err }
+
+	return gobip39.GetEntropyFromBytes(secret)
+}
+
+// Helper method that recovers the Entropy with Combine and then
+// calls GetMnemonicFromEntropy.
+// An error is returned if combining fails.
+func CombineMnemonic(shares []Share) (gobip39.Mnemonic, error) {
+	ent, err := Combine(shares)
+
+	if (err != nil) { return gobip39.Mnemonic{}, err }
+
+	return gobip39.GetMnemonicFromEntropy(ent)
+}
+
+// Serialize the share as its 5 byte metadata followed by its value.
+func (share Share) Bytes() []byte {
+	data := binary.BigEndian.AppendUint16(nil, share.Identifier)
+	data = append(data,
+		(share.GroupThreshold - 1) << 4 | (share.GroupCount - 1) & 0xF,
+		share.GroupIndex << 4 | (share.MemberThreshold - 1) & 0xF,
+		share.MemberIndex & 0xF)
+
+	return append(data, share.Value...)
+}
+
+// Parse a serialized share.
+// An error is returned if the share is too short, its value has an
+// invalid length, its reserved bits are set, or its parameters are
+// inconsistent.
+func ParseShare(data []byte) (Share, error) {
+	if (len(data) < MetadataLength + MinimumSecretLength || len(data) > MetadataLength + MaximumSecretLength || (len(data) - MetadataLength) % 2 != 0) {
+		return Share{}, sskrError{Message: "Share has an invalid length."}
+	}
+
+	if (data[4] >> 4 != 0) {
+		return Share{}, sskrError{Message: "Share has reserved bits set."}
+	}
+
+	share := Share{
+		Identifier: binary.BigEndian.Uint16(data),
+		GroupThreshold: data[2] >> 4 + 1,
+		GroupCount: data[2] & 0xF + 1,
+		GroupIndex: data[3] >> 4,
+		MemberThreshold: data[3] & 0xF + 1,
+		MemberIndex: data[4] & 0xF,
+		Value: append([]byte{}, data[MetadataLength:]...),
+	}
+
+	if (share.GroupThreshold > share.GroupCount || share.GroupIndex >= share.GroupCount) {
+		return Share{}, sskrError{Message: "Share has inconsistent group parameters."}
+	}
+
+	return share, nil
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"testing"
+	"encoding/hex"
+	"strings"
+	"time"
+	"gobip39"
+	"gobip39/aezeed"
+)
+
+// Inputs of LND's aezeed test vectors, with the sentences LND produces
+// at the default N = 32768.
+var aezeedVectors = []struct {
+	birthday time.Time
+	passphrase string
+	sentence string
+	days uint16
+}{
+	{aezeed.BitcoinGenesisDate, "", "above judge emerge veteran reform crunch system all snap please shoulder vault hurt city quarter cover enlist swear success suggest drink wagon enrich body", 0},
+	{time.Unix(1521799345, 0), "!very_safe_55345_password*", "absorb century submit father path glove gloom super divert garden ice mirror wisdom grass dice kit ugly castle success suggest drink monster congress flight", 3365},
+}
+
+const AEZEED_ENTROPY = "81b637d86359e6960de795e41e0b4cfd"
+
+// "salt1"
+var AEZEED_SALT = [aezeed.SaltSize]byte{0x73, 0x61, 0x6c, 0x74, 0x31}
+
+func TestAezeed_GetSentence_MatchesVectors(t *testing.T) {
+	data, _ := hex.DecodeString(AEZEED_ENTROPY)
+	ent, _ := gobip39.GetEntropyFromBytes(data)
+
+	for _, vector := range aezeedVectors {
+		seed, err := aezeed.New(0, ent, vector.birthday)
+
+		if (err != nil) {
+			t.Fatal("Failed to create aezeed:", err.Error())
+		}
+
+		seed.Salt = AEZEED_SALT
+
+		if (seed.Birthday != vector.days) {
+			t.Error("Expected birthday", seed.Birthday, "to equal", vector.days)
+		}
+
+		sentence, sentenceErr := seed.GetSentence(vector.passphrase)
+
+		if (sentenceErr != nil) {
+			t.Fatal("Failed to encipher aezeed:", sentenceErr.Error())
+		}
+
+		if actual := strings.Join(sentence, " "); actual != vector.sentence {
+			t.Error("Expected aezeed", actual, "to equal", vector.sentence)
+		}
+	}
+}
+
+func TestAezeed_Decipher_RecoversSeed(t *testing.T) {
+	vector := aezeedVectors[1]
+
+	seed, err := aezeed.Decipher(strings.Split(vector.sentence, " "), vector.passphrase)
+
+	if (err != nil) {
+		t.Fatal("Failed to decipher aezeed:", err.Error())
+	}
+
+	if actual := hex.EncodeToString(seed.GetEntropy().Data); actual != AEZEED_ENTROPY {
+		t.Error("Expected entropy", actual, "to equal", AEZEED_ENTROPY)
+	}
+
+	if (seed.Birthday != vector.days || seed.Salt != AEZEED_SALT || seed.InternalVersion != 0) {
+		t.Error("Expected aezeed fields", seed, "to match the vector.")
+	}
+
+	// Birthdays are whole days
+	if (seed.BirthdayTime().After(vector.birthday) || vector.birthday.Sub(seed.BirthdayTime()) >= 24 * time.Hour) {
+		t.Error("Expected birthday", seed.BirthdayTime(), "to be the day of", vector.birthday)
+	}
+}
+
+func TestAezeed_Decipher_FailsOnWrongPassphrase(t *testing.T) {
+	vector := aezeedVectors[1]
+
+	if _, err := aezeed.Decipher(strings.Split(vector.sentence, " "), "wrong"); err != aezeed.ErrInvalidPassphrase {
+		t.Error("Expected ErrInvalidPassphrase, got", err)
+	}
+}
+
+func TestAezeed_Decipher_FailsOnInvalidChecksum(t *testing.T) {
+	sentence := strings.Split(aezeedVectors[0].sentence, " ")
+	sentence[23] = "abandon"
+
+	if _, err := aezeed.Decipher(sentence, ""); err != aezeed.ErrInvalidChecksum {
+		t.Error("Expected ErrInvalidChecksum, got", err)
+	}
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"testing"
+	"encoding/hex"
+	"strings"
+	"gobip39"
+	"gobip39/wordlist"
+)
+
+func TestAssess_Assess_FindsConstantEntropy(t *testing.T) {
+	ent, _ := gobip39.GetEntropyFromBytes(make([]byte, 16))
+	weaknesses := ent.Assess()
+
+	for _, expected := range []gobip39.Weakness{gobip39.WeaknessConstant, gobip39.WeaknessFewDistinctWords, gobip39.WeaknessKnownMnemonic} {
+		if (!hasWeakness(weaknesses, expected)) {
+			t.Error("Expected", weaknesses, "to contain", expected)
+		}
+	}
+}
+
+func TestAssess_Assess_FindsRepeatingEntropy(t *testing.T) {
+	data, _ := hex.DecodeString("0123456789abcdef0123456789abcdef")
+	ent, _ := gobip39.GetEntropyFromBytes(data)
+
+	if (!hasWeakness(ent.Assess(), gobip39.WeaknessRepeating) || hasWeakness(ent.Assess(), gobip39.WeaknessConstant)) {
+		t.Error("Expected", ent.Assess(), "to contain only", gobip39.WeaknessRepeating)
+	}
+}
+
+func TestAssess_Assess_FindsSequentialBytes(t *testing.T) {
+	data := make([]byte, 32)
+
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	ent, _ := gobip39.GetEntropyFromBytes(data)
+
+	if (!hasWeakness(ent.Assess(), gobip39.WeaknessSequential)) {
+		t.Error("Expected", ent.Assess(), "to contain", gobip39.WeaknessSequential)
+	}
+}
+
+func TestAssess_Assess_FindsSequentialWords(t *testing.T) {
+	// Words 0 to 10 of the list, then a checksum word
+	mnemonic, _ := gobip39.GetMnemonicFromSentence(strings.Fields("abandon ability able about above absent absorb abstract absurd abuse access ability"), wordlist.English)
+	weaknesses := mnemonic.Entropy.Assess()
+
+	if (len(weaknesses) != 1 || weaknesses[0] != gobip39.WeaknessSequential) {
+		t.Error("Expected", weaknesses, "to equal", []gobip39.Weakness{gobip39.WeaknessSequential})
+	}
+}
+
+func TestAssess_Assess_FindsKnownMnemonics(t *testing.T) {
+	for _, sentence := range []string{
+		"test test test test test test test test test test test junk",
+		"vessel ladder alter error federal sibling chat ability sun glass valve picture",
+	} {
+		mnemonic, _ := gobip39.GetMnemonicFromSentence(strings.Fields(sentence), wordlist.English)
+
+		if (!hasWeakness(mnemonic.Entropy.Assess(), gobip39.WeaknessKnownMnemonic)) {
+			t.Error("Expected", mnemonic.Entropy.Assess(), "to contain", gobip39.WeaknessKnownMnemonic)
+		}
+	}
+}
+
+func TestAssess_Assess_FindsNothingInRandomEntropy(t *testing.T) {
+	reader := gobip39.NewDeterministicReader([]byte("gobip39"))
+
+	for i := 0; i < 100; i++ {
+		ent, _ := gobip39.GenerateEntropyFrom(128, reader)
+
+		if (len(ent.Assess()) != 0) {
+			t.Error("Expected", ent.Assess(), "of", ent.Data, "to be empty")
+		}
+	}
+}
+
+func TestAssess_Assess_LeavesEntropyUnchanged(t *testing.T) {
+	data := make([]byte, 16, 32)
+	ent, _ := gobip39.GetEntropyFromBytes(data)
+	ent.Assess()
+
+	if (data[:17][16] != 0) {
+		t.Error("Expected Assess not to write past the Entropy's data.")
+	}
+}
+
+func hasWeakness(weaknesses []gobip39.Weakness, weakness gobip39.Weakness) bool {
+	for _, found := range weaknesses {
+		if (found == weakness) { return true }
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"testing"
+	"io/ioutil"
+	"encoding/json"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"gobip39/bip85"
+	"gobip39/slip10"
+	"gobip39/wordlist"
+)
+
+type bip85Vectors struct {
+	Master string `json:"master"`
+	Entropy [][]string `json:"entropy"`
+	BIP39 [][]string `json:"bip39"`
+	Hex []string `json:"hex"`
+	WIF string `json:"wif"`
+	XPRV string `json:"xprv"`
+	Base64 []string `json:"base64"`
+	Base85 []string `json:"base85"`
+}
+
+func readBip85Vectors(t *testing.T) (bip85Vectors, slip10.Key) {
+	file, err := ioutil.ReadFile("./bip85_vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'bip85_vectors.json':", err.Error())
+	}
+
+	var vectors bip85Vectors
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	master, parseErr := slip10.ParseExtendedKey(vectors.Master)
+
+	if (parseErr != nil) {
+		t.Fatal("Failed to parse master key:", parseErr.Error())
+	}
+
+	return vectors, master
+}
+
+func parseUint32(s string) uint32 {
+	value, _ := strconv.ParseUint(s, 10, 32)
+	return uint32(value)
+}
+
+func TestBip85_DeriveEntropy(t *testing.T) {
+	vectors, master := readBip85Vectors(t)
+
+	for _, v := range vectors.Entropy {
+		entropy, err := bip85.DeriveEntropy(master, 0, parseUint32(v[0]))
+
+		if (err != nil) {
+			t.Fatal("Failed to derive entropy:", err.Error())
+		}
+
+		if actual := hex.EncodeToString(entropy); actual != v[1] {
+			t.Error("Expected derived entropy", actual, "to equal", v[1])
+		}
+	}
+}
+
+func TestBip85_DeriveMnemonic(t *testing.T) {
+	vectors, master := readBip85Vectors(t)
+
+	for _, v := range vectors.BIP39 {
+		mnemonic, err := bip85.DeriveMnemonic(master, wordlist.English, parseUint32(v[0]), 0)
+
+		if (err != nil) {
+			t.Fatal("Failed to derive Mnemonic:", err.Error())
+		}
+
+		sentence, _ := mnemonic.GetSentenceFrom(wordlist.English)
+
+		if joined := strings.Join(sentence, " "); joined != v[1] {
+			t.Error("Expected derived sentence", joined, "to equal", v[1])
+		}
+	}
+}
+
+func TestBip85_DeriveMnemonic_FailsOnInvalidWordCount(t *testing.T) {
+	_, master := readBip85Vectors(t)
+
+	if _, err := bip85.DeriveMnemonic(master, wordlist.English, 15, 0); err == nil {
+		t.Error("Expected DeriveMnemonic to return an error for 15 words.")
+	}
+}
+
+func TestBip85_DeriveHex(t *testing.T) {
+	vectors, master := readBip85Vectors(t)
+
+	actual, err := bip85.DeriveHex(master, parseUint32(vectors.Hex[0]), 0)
+
+	if (err != nil || actual != vectors.Hex[1]) {
+		t.Error("Expected derived hex", actual, "to equal", vectors.Hex[1])
+	}
+}
+
+func TestBip85_DeriveWIF(t *testing.T) {
+	vectors, master := readBip85Vectors(t)
+
+	actual, err := bip85.DeriveWIF(master, 0)
+
+	if (err != nil || actual != vectors.WIF) {
+		t.Error("Expected derived WIF", actual, "to equal", vectors.WIF)
+	}
+}
+
+func TestBip85_DeriveXPRV(t *testing.T) {
+	vectors, master := readBip85Vectors(t)
+
+	key, err := bip85.DeriveXPRV(master, 0)
+
+	if (err != nil) {
+		t.Fatal("Failed to derive XPRV:", err.Error())
+	}
+
+	if actual, _ := key.Serialize(); actual != vectors.XPRV {
+		t.Error("Expected derived XPRV", actual, "to equal", vectors.XPRV)
+	}
+}
+
+func TestBip85_DerivePasswords(t *testing.T) {
+	vectors, master := readBip85Vectors(t)
+
+	base64Password, base64Err := bip85.DeriveBase64Password(master, parseUint32(vectors.Base64[0]), 0)
+
+	if (base64Err != nil || base64Password != vectors.Base64[1]) {
+		t.Error("Expected derived Base64 password", base64Password, "to equal", vectors.Base64[1])
+	}
+
+	base85Password, base85Err := bip85.DeriveBase85Password(master, parseUint32(vectors.Base85[0]), 0)
+
+	if (base85Err != nil || base85Password != vectors.Base85[1]) {
+		t.Error("Expected derived Base85 password", base85Password, "to equal", vectors.Base85[1])
+	}
+}
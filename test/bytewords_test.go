@@ -0,0 +1,87 @@
+package test
+
+import (
+	"testing"
+	"gobip39/bytewords"
+	"gobip39/cbor"
+	"gobip39/ur"
+	"bytes"
+)
+
+func TestBytewords_EncodeStyles(t *testing.T) {
+	data := []byte{0x00, 0x01, 0x02, 0x80, 0xFF}
+
+	expected := map[bytewords.Style]string{
+		bytewords.Standard: "able acid also lava zoom jade need echo taxi",
+		bytewords.URI: "able-acid-also-lava-zoom-jade-need-echo-taxi",
+		bytewords.Minimal: "aeadaolazmjendeoti",
+	}
+
+	for style, encoded := range expected {
+		if actual := bytewords.Encode(data, style); actual != encoded {
+			t.Error("Expected Bytewords", actual, "to equal", encoded)
+		}
+
+		decoded, err := bytewords.Decode(encoded, style)
+
+		if (err != nil || !bytes.Equal(decoded, data)) {
+			t.Error("Expected", encoded, "to decode to", data)
+		}
+	}
+}
+
+func TestBytewords_Decode_FailsOnInvalidInput(t *testing.T) {
+	invalid := map[bytewords.Style]string{
+		bytewords.Standard: "able acid also lava zoom jade need echo tuna",
+		bytewords.URI: "able-acid-also-lava-zero-jade-need-echo-taxi",
+		bytewords.Minimal: "aeadaolazmjendeotx",
+	}
+
+	for style, encoded := range invalid {
+		if _, err := bytewords.Decode(encoded, style); err == nil {
+			t.Error("Expected", encoded, "to fail to decode.")
+		}
+	}
+}
+
+func TestUR_StringAndParseRoundTrip(t *testing.T) {
+	resource := ur.UR{Type: "bytes", CBOR: cbor.EncodeBytes([]byte("Hello, world!"))}
+
+	encoded, err := resource.String()
+
+	if (err != nil) {
+		t.Fatal("Failed to encode UR:", err.Error())
+	}
+
+	// Uppercase URs come from alphanumeric QR codes
+	parsed, parseErr := ur.Parse(string(bytes.ToUpper([]byte(encoded))))
+
+	if (parseErr != nil || parsed.Type != resource.Type || !bytes.Equal(parsed.CBOR, resource.CBOR)) {
+		t.Error("Expected", encoded, "to parse back into the UR.")
+	}
+
+	if _, invalidErr := (ur.UR{Type: "Not Valid", CBOR: resource.CBOR}).String(); invalidErr == nil {
+		t.Error("Expected String to return an error for an invalid UR type.")
+	}
+}
+
+func TestCbor_EncodesShortestHeads(t *testing.T) {
+	expected := map[uint64][]byte{
+		0: {0x00},
+		23: {0x17},
+		24: {0x18, 0x18},
+		500: {0x19, 0x01, 0xF4},
+		70000: {0x1A, 0x00, 0x01, 0x11, 0x70},
+		1 << 32: {0x1B, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00},
+	}
+
+	for value, encoded := range expected {
+		if actual := cbor.EncodeUnsigned(value); !bytes.Equal(actual, encoded) {
+			t.Error("Expected CBOR encoding of", value, actual, "to equal", encoded)
+		}
+
+		if decoded, err := cbor.NewDecoder(encoded).ReadUnsigned(); err != nil || decoded != value {
+			t.Error("Expected", encoded, "to decode to", value)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package test
+
+import (
+	"testing"
+	"io/ioutil"
+	"encoding/json"
+	"encoding/hex"
+	"gobip39"
+	"gobip39/cardano"
+	"bytes"
+)
+
+type icarusVector struct {
+	Entropy string `json:"entropy"`
+	Passphrase string `json:"passphrase"`
+	Master string `json:"master"`
+}
+
+func TestCardano_IcarusVectors(t *testing.T) {
+	file, err := ioutil.ReadFile("./cardano_vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'cardano_vectors.json':", err.Error())
+	}
+
+	var vectors struct {
+		Icarus []icarusVector `json:"icarus"`
+	}
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	for _, v := range vectors.Icarus {
+		data, _ := hex.DecodeString(v.Entropy)
+		entropy := gobip39.Entropy{Size: uint16(len(data)) * 8, Data: data}
+
+		master, masterErr := cardano.NewIcarusMasterKey(entropy, v.Passphrase)
+
+		if (masterErr != nil) {
+			t.Error("Failed to generate Icarus master key:", masterErr.Error())
+			continue
+		}
+
+		if actual := hex.EncodeToString(master.Bytes()); actual != v.Master {
+			t.Error("Expected Icarus master key", actual, "to equal", v.Master)
+		}
+	}
+}
+
+type cip1852Vector struct {
+	Entropy string `json:"entropy"`
+	Passphrase string `json:"passphrase"`
+	Account uint32 `json:"account"`
+	Role uint32 `json:"role"`
+	Index uint32 `json:"index"`
+	Public string `json:"public"`
+}
+
+// The 'cip1852' vectors are the spending and staking keys of the
+// cardano-serialization-lib 'bip32_15_base' test, whose base address
+// 'addr_test1qpu5vlrf4xkxv2qpwngf6cjhtw542ayty80v8dyr49rf5ewvxwdrt70qlcpeeagscasafhffqsxy36t90ldv06wqrk2qum8x5w'
+// commits to the Blake2b-224 hashes of both keys.
+func TestCardano_CIP1852Vectors(t *testing.T) {
+	file, err := ioutil.ReadFile("./cardano_vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'cardano_vectors.json':", err.Error())
+	}
+
+	var vectors struct {
+		CIP1852 []cip1852Vector `json:"cip1852"`
+	}
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	if (len(vectors.CIP1852) == 0) {
+		t.Fatal("Expected vector file to contain CIP-1852 vectors.")
+	}
+
+	for _, v := range vectors.CIP1852 {
+		data, _ := hex.DecodeString(v.Entropy)
+		entropy := gobip39.Entropy{Size: uint16(len(data)) * 8, Data: data}
+
+		master, masterErr := cardano.NewIcarusMasterKey(entropy, v.Passphrase)
+
+		if (masterErr != nil) {
+			t.Error("Failed to generate Icarus master key:", masterErr.Error())
+			continue
+		}
+
+		account, accountErr := master.DeriveAccountKey(v.Account)
+
+		if (accountErr != nil) {
+			t.Error("Failed to derive account key:", accountErr.Error())
+			continue
+		}
+
+		key, keyErr := account.DeriveAddressKey(cardano.Role(v.Role), v.Index)
+
+		if (keyErr != nil) {
+			t.Error("Failed to derive address key:", keyErr.Error())
+			continue
+		}
+
+		if actual := hex.EncodeToString(key.Public().Bytes()[:32]); actual != v.Public {
+			t.Error("Expected CIP-1852 public key", actual, "to equal", v.Public)
+		}
+	}
+}
+
+func TestCardano_NewIcarusMasterKey_FailsOnInvalidEntropy(t *testing.T) {
+	entropy := gobip39.Entropy{Size: 64, Data: make([]byte, 8)}
+
+	if _, err := cardano.NewIcarusMasterKey(entropy); err == nil {
+		t.Error("Expected NewIcarusMasterKey to return an error when entropy size is invalid.")
+	}
+}
+
+func TestCardano_PublicDerivationMatchesPrivateDerivation(t *testing.T) {
+	entropy, _ := gobip39.GetEntropyFromBytes(make([]byte, 16))
+
+	master, _ := cardano.NewIcarusMasterKey(entropy)
+
+	account, err := master.DeriveAccountKey(0)
+
+	if (err != nil) {
+		t.Fatal("Failed to derive account key:", err.Error())
+	}
+
+	for _, role := range []cardano.Role{cardano.ExternalChain, cardano.InternalChain, cardano.StakingKey} {
+		for index := uint32(0); index < 3; index++ {
+			privateChild, privateErr := account.DeriveAddressKey(role, index)
+			publicChild, publicErr := account.Public().DeriveAddressKey(role, index)
+
+			if (privateErr != nil || publicErr != nil) {
+				t.Fatal("Failed to derive address key.")
+			}
+
+			if (!bytes.Equal(privateChild.Public().Bytes(), publicChild.Bytes())) {
+				t.Error("Expected public derivation of", role, index, "to match private derivation.")
+			}
+		}
+	}
+}
+
+func TestCardano_PublicKey_Derive_FailsOnHardenedIndex(t *testing.T) {
+	entropy, _ := gobip39.GetEntropyFromBytes(make([]byte, 16))
+
+	master, _ := cardano.NewIcarusMasterKey(entropy)
+
+	if _, err := master.Public().Derive(cardano.HardenedOffset); err == nil {
+		t.Error("Expected public key derivation to return an error for a hardened index.")
+	}
+}
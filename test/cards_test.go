@@ -0,0 +1,86 @@
+package test
+
+import (
+	"testing"
+	"encoding/hex"
+	"math"
+	"strings"
+	"gobip39"
+)
+
+// Cards in deck order: spades, hearts, diamonds, clubs from ace to king
+func deckOrder() []string {
+	cards := []string{}
+
+	for _, suit := range "SHDC" {
+		for _, rank := range []string{"A", "2", "3", "4", "5", "6", "7", "8", "9", "10", "J", "Q", "K"} {
+			cards = append(cards, rank + string(suit))
+		}
+	}
+
+	return cards
+}
+
+func TestCards_FullDeckEntropyBits_IsLog2Of52Factorial(t *testing.T) {
+	if (math.Abs(gobip39.FullDeckEntropyBits - 225.581) > 0.001) {
+		t.Error("Expected", gobip39.FullDeckEntropyBits, "to equal", 225.581)
+	}
+}
+
+func TestCards_GetEntropyFromCards_HashesLehmerCode(t *testing.T) {
+	deck := deckOrder()
+
+	for i, j := 0, len(deck) - 1; i < j; i, j = i + 1, j - 1 {
+		deck[i], deck[j] = deck[j], deck[i]
+	}
+
+	// The reversed deck has the largest Lehmer code, 52! - 1
+	ent, supplied, err := gobip39.GetEntropyFromCards(strings.Join(deck, " "), 256)
+	expected := "19183da9870c88f56baa2708ecee51fb419bcba010fad2705f356a419bf67a84"
+
+	if (err != nil || hex.EncodeToString(ent.Data) != expected) {
+		t.Error("Expected", hex.EncodeToString(ent.Data), err, "to equal", expected)
+	}
+
+	if (supplied != gobip39.FullDeckEntropyBits) {
+		t.Error("Expected", supplied, "to equal", gobip39.FullDeckEntropyBits)
+	}
+}
+
+func TestCards_GetEntropyFromCards_AcceptsPartialDeck(t *testing.T) {
+	// 30 cards in deck order have a Lehmer code of 0
+	ent, supplied, err := gobip39.GetEntropyFromCards(strings.ToLower(strings.Join(deckOrder()[:30], " ")), 128)
+	expected := "11e431c215c5bd334cecbd43148274ed"
+
+	if (err != nil || hex.EncodeToString(ent.Data) != expected) {
+		t.Error("Expected", hex.EncodeToString(ent.Data), err, "to equal", expected)
+	}
+
+	if (math.Abs(supplied - 155.652) > 0.001) {
+		t.Error("Expected", supplied, "to equal", 155.652)
+	}
+}
+
+func TestCards_GetEntropyFromCards_FailsOnTooFewCards(t *testing.T) {
+	_, supplied, err := gobip39.GetEntropyFromCards(strings.Join(deckOrder()[:20], " "), 128)
+
+	if (!isEntropyError(err) || supplied >= 128) {
+		t.Error("Expected", supplied, err, "to be below 128 with an", ENTROPY_ERROR)
+	}
+}
+
+func TestCards_GetEntropyFromCards_FailsOnRepeatedCard(t *testing.T) {
+	cards := strings.Join(deckOrder()[:40], " ") + " AS"
+
+	if _, _, err := gobip39.GetEntropyFromCards(cards, 128); !isEntropyError(err) {
+		t.Error("Expected", err, "to be an", ENTROPY_ERROR)
+	}
+}
+
+func TestCards_GetEntropyFromCards_FailsOnUnknownCard(t *testing.T) {
+	cards := strings.Join(deckOrder()[:40], " ") + " 1S"
+
+	if _, _, err := gobip39.GetEntropyFromCards(cards, 128); !isEntropyError(err) {
+		t.Error("Expected", err, "to be an", ENTROPY_ERROR)
+	}
+}
@@ -0,0 +1,152 @@
+package test
+
+import (
+	"testing"
+	"encoding/hex"
+	"strings"
+	"gobip39"
+	"gobip39/codex32"
+)
+
+// Test vectors from BIP-0093
+var codex32Secrets = []struct {
+	encoded string
+	seed string
+}{
+	{"ms10testsxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczlw", "318c6318c6318c6318c6318c6318c631"},
+	{"MS12NAMES6XQGUZTTXKEQNJSJZV4JV3NZ5K3KWGSPHUH6EVW", "d1808e096b35b209ca12132b264662a5"},
+	{"ms13cashsllhdmn9m42vcsamx24zrxgs3qqjzqud4m0d6nln", "ffeeddccbbaa99887766554433221100"},
+	{"ms10leetsllhdmn9m42vcsamx24zrxgs3qrl7ahwvhw4fnzrhve25gvezzyqqtum9pgv99ycma", "ffeeddccbbaa99887766554433221100ffeeddccbbaa99887766554433221100"},
+	{"MS100C8VSM32ZXFGUHPCHTLUPZRY9X8GF2TVDW0S3JN54KHCE6MUA7LQPZYGSFJD6AN074RXVCEMLH8WU3TK925ACDEFGHJKLMNPQRSTUVWXY06FHPV80UNDVARHRAK", "dc5423251cb87175ff8110c8531d0952d8d73e1194e95b5f19d6f9df7c01111104c9baecdfea8cccc677fb9ddc8aec5553b86e528bcadfdcc201c17c638c47e9"},
+}
+
+const CODEX32_SHARE_A = "MS12NAMEA320ZYXWVUTSRQPNMLKJHGFEDCAXRPP870HKKQRM"
+const CODEX32_SHARE_C = "MS12NAMECACDEFGHJKLMNPQRSTUVWXYZ023FTR2GDZMPY6PN"
+const CODEX32_SHARE_D = "MS12NAMEDLL4F8JLH4E5VDVULDLFXU2JHDNLSM97XVENRXEG"
+
+func TestCodex32_Parse_ReadsVectors(t *testing.T) {
+	for _, vector := range codex32Secrets {
+		share, err := codex32.Parse(vector.encoded)
+
+		if (err != nil) {
+			t.Fatal("Failed to parse", vector.encoded, ":", err.Error())
+		}
+
+		if actual := hex.EncodeToString(share.Payload()); actual != vector.seed {
+			t.Error("Expected seed", actual, "to equal", vector.seed)
+		}
+
+		if (share.Index != codex32.SecretIndex) {
+			t.Error("Expected", vector.encoded, "to be a secret share.")
+		}
+	}
+}
+
+func TestCodex32_String_RoundTrips(t *testing.T) {
+	for _, encoded := range []string{codex32Secrets[0].encoded, CODEX32_SHARE_A, codex32Secrets[3].encoded} {
+		share, _ := codex32.Parse(encoded)
+
+		if actual, err := share.String(); err != nil || actual != strings.ToLower(encoded) {
+			t.Error("Expected", actual, "to equal", strings.ToLower(encoded))
+		}
+	}
+}
+
+func TestCodex32_Combine_RecoversVectorSecret(t *testing.T) {
+	expected := strings.ToLower(codex32Secrets[1].encoded)
+
+	for _, pair := range [][2]string{{CODEX32_SHARE_A, CODEX32_SHARE_C}, {CODEX32_SHARE_D, CODEX32_SHARE_A}} {
+		first, _ := codex32.Parse(pair[0])
+		second, _ := codex32.Parse(pair[1])
+
+		secret, err := codex32.Combine([]codex32.Share{first, second})
+
+		if (err != nil) {
+			t.Fatal("Failed to combine shares:", err.Error())
+		}
+
+		if actual, _ := secret.String(); actual != expected {
+			t.Error("Expected secret", actual, "to equal", expected)
+		}
+	}
+}
+
+func TestCodex32_Combine_ChecksExtraShares(t *testing.T) {
+	a, _ := codex32.Parse(CODEX32_SHARE_A)
+	c, _ := codex32.Parse(CODEX32_SHARE_C)
+	d, _ := codex32.Parse(CODEX32_SHARE_D)
+
+	if _, err := codex32.Combine([]codex32.Share{a, c, d}); err != nil {
+		t.Error("Expected consistent extra share to be accepted:", err.Error())
+	}
+
+	// A share of another secret with the same header
+	other, _ := codex32.Split(make([]byte, 16), 2, 4, "name")
+
+	if _, err := codex32.Combine([]codex32.Share{a, c, other[2]}); err == nil {
+		t.Error("Expected inconsistent extra share to be rejected.")
+	}
+
+	if _, err := codex32.Combine([]codex32.Share{a, a}); err == nil {
+		t.Error("Expected a repeated share index to be rejected.")
+	}
+
+	if _, err := codex32.Combine([]codex32.Share{a}); err == nil {
+		t.Error("Expected a single share below the threshold to be rejected.")
+	}
+}
+
+func TestCodex32_SplitEntropy_RecoversFromAnySubset(t *testing.T) {
+	ent, _ := gobip39.GenerateEntropy(256)
+
+	shares, err := codex32.SplitEntropy(ent, 3, 5, "cash")
+
+	if (err != nil) {
+		t.Fatal("Failed to split entropy:", err.Error())
+	}
+
+	// Shares must survive being written down and read back
+	for i, share := range shares {
+		encoded, _ := share.String()
+		shares[i], err = codex32.Parse(strings.ToUpper(encoded))
+
+		if (err != nil) {
+			t.Fatal("Failed to parse share", encoded, ":", err.Error())
+		}
+	}
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}} {
+		chosen := []codex32.Share{}
+		for _, index := range subset {
+			chosen = append(chosen, shares[index])
+		}
+
+		recovered, recoverErr := codex32.RecoverEntropy(chosen)
+
+		if (recoverErr != nil || hex.EncodeToString(recovered.Data) != hex.EncodeToString(ent.Data)) {
+			t.Error("Expected shares", subset, "to recover the entropy.")
+		}
+	}
+}
+
+func TestCodex32_Parse_FailsOnInvalidStrings(t *testing.T) {
+	valid := codex32Secrets[0].encoded
+
+	invalid := []string{
+		// Mixed case
+		"ms10testsxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczlW",
+		// Checksum error
+		strings.Replace(valid, "4nzv", "4nzw", 1),
+		// Wrong prefix
+		"bc" + valid[2:],
+		// Unshared secrets must use index s
+		"ms10testaxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczlw",
+		valid[:20],
+	}
+
+	for _, encoded := range invalid {
+		if _, err := codex32.Parse(encoded); err == nil {
+			t.Error("Expected", encoded, "to fail to parse.")
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package test
+
+import (
+	"testing"
+	"gobip39"
+	"gobip39/bech32"
+	"gobip39/cosmos"
+	"gobip39/slip10"
+	"bytes"
+)
+
+const ABANDON_SENTENCE = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestCosmos_Address_MatchesKnownAccounts(t *testing.T) {
+	seed := gobip39.GenerateBinarySeed(ABANDON_SENTENCE)
+
+	expected := []string{
+		"cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4",
+	}
+
+	for i, account := range expected {
+		key, err := cosmos.DeriveKey(seed, uint32(i))
+
+		if (err != nil) {
+			t.Fatal("Failed to derive Cosmos key:", err.Error())
+		}
+
+		address, addressErr := cosmos.Address(key, cosmos.CosmosPrefix)
+
+		if (addressErr != nil) {
+			t.Fatal("Failed to encode Cosmos address:", addressErr.Error())
+		}
+
+		if (address != account) {
+			t.Error("Expected Cosmos address", address, "to equal", account)
+		}
+	}
+}
+
+func TestCosmos_Address_SharesAccountAcrossPrefixes(t *testing.T) {
+	seed := gobip39.GenerateBinarySeed(ABANDON_SENTENCE)
+	key, _ := cosmos.DeriveKey(seed, 0)
+
+	account, _ := cosmos.AccountAddress(key)
+
+	for _, prefix := range []string{cosmos.CosmosPrefix, cosmos.OsmosisPrefix, cosmos.JunoPrefix} {
+		address, err := cosmos.Address(key, prefix)
+
+		if (err != nil) {
+			t.Fatal("Failed to encode address with prefix", prefix, "\b:", err.Error())
+		}
+
+		hrp, data, decodeErr := bech32.Decode(address)
+
+		if (decodeErr != nil || hrp != prefix || !bytes.Equal(data, account)) {
+			t.Error("Expected address", address, "to decode to prefix", prefix, "and the shared account.")
+		}
+	}
+}
+
+func TestCosmos_AccountAddress_FailsOnEd25519Key(t *testing.T) {
+	key, _ := slip10.NewMasterKey(make([]byte, 16), slip10.Ed25519)
+
+	if _, err := cosmos.AccountAddress(key); err == nil {
+		t.Error("Expected AccountAddress to return an error for an Ed25519 key.")
+	}
+}
+
+func TestBech32_ValidChecksums(t *testing.T) {
+	valid := []string{
+		"A12UEL5L",
+		"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+		"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w",
+	}
+
+	for _, encoded := range valid {
+		if _, _, err := bech32.DecodeGroups(encoded); err != nil {
+			t.Error("Expected", encoded, "to be valid Bech32:", err.Error())
+		}
+	}
+}
+
+func TestBech32_InvalidChecksums(t *testing.T) {
+	invalid := []string{
+		"pzry9x0s0muk",
+		"1pzry9x0s0muk",
+		"x1b4n0q5v",
+		"li1dgmt3",
+		"A1G7SGD8",
+		"10a06t8",
+		"1qzzfhee",
+	}
+
+	for _, encoded := range invalid {
+		if _, _, err := bech32.DecodeGroups(encoded); err == nil {
+			t.Error("Expected", encoded, "to be invalid Bech32.")
+		}
+	}
+}
+
+func TestBech32_EncodeGroups_LeavesSpareCapacityUntouched(t *testing.T) {
+	backing := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	groups := backing[:4]
+
+	if _, err := bech32.EncodeGroups("test", groups); err != nil {
+		t.Fatal("Failed to encode groups:", err.Error())
+	}
+
+	if expected := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}; !bytes.Equal(backing, expected) {
+		t.Error("Expected backing array", backing, "to equal", expected)
+	}
+}
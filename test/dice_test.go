@@ -0,0 +1,94 @@
+package test
+
+import (
+	"testing"
+	"bytes"
+	"strings"
+	"gobip39"
+	"gobip39/wordlist"
+)
+
+func TestDice_MinimumDiceRolls_CoversEntropySize(t *testing.T) {
+	if (gobip39.MinimumDiceRolls(128) != 50 || gobip39.MinimumDiceRolls(256) != 100) {
+		t.Error("Expected", gobip39.MinimumDiceRolls(128), gobip39.MinimumDiceRolls(256), "to equal", 50, 100)
+	}
+}
+
+func TestDice_GetEntropyFromDiceRolls_ConvertsWithoutBias(t *testing.T) {
+	// 1, 2, 3, 4 give 00 01 10 11
+	ent, supplied, err := gobip39.GetEntropyFromDiceRolls(strings.Repeat("1234 ", 16), 128)
+	expected := bytes.Repeat([]byte{0x1B}, 16)
+
+	if (err != nil || !bytes.Equal(ent.Data, expected)) {
+		t.Error("Expected", ent.Data, err, "to equal", expected)
+	}
+
+	if (supplied != 128) {
+		t.Error("Expected", supplied, "to equal", 128)
+	}
+
+	// 5 and 6 give 0 and 1; the extra rolls are reported but unused
+	ent, supplied, _ = gobip39.GetEntropyFromDiceRolls(strings.Repeat("56", 65), 128)
+	expected = bytes.Repeat([]byte{0x55}, 16)
+
+	if (!bytes.Equal(ent.Data, expected) || supplied != 130) {
+		t.Error("Expected", ent.Data, supplied, "to equal", expected, 130)
+	}
+}
+
+func TestDice_GetEntropyFromDiceRolls_FailsOnTooFewRolls(t *testing.T) {
+	if _, _, err := gobip39.GetEntropyFromDiceRolls(strings.Repeat("4", 49), 128); !isEntropyError(err) {
+		t.Error("Expected", err, "to be an", ENTROPY_ERROR)
+	}
+
+	// Enough rolls, but 5 and 6 only supply 1 bit each
+	_, supplied, err := gobip39.GetEntropyFromDiceRolls(strings.Repeat("6", 100), 128)
+
+	if (!isEntropyError(err) || supplied != 100) {
+		t.Error("Expected", supplied, err, "to equal", 100, ENTROPY_ERROR)
+	}
+}
+
+func TestDice_GetEntropyFromDiceRolls_FailsOnNonRoll(t *testing.T) {
+	if _, _, err := gobip39.GetEntropyFromDiceRolls(strings.Repeat("1234", 16) + "7", 128); !isEntropyError(err) {
+		t.Error("Expected", err, "to be an", ENTROPY_ERROR)
+	}
+}
+
+func TestDice_GetEntropyFromCoinFlips_FeedsMnemonic(t *testing.T) {
+	ent, supplied, err := gobip39.GetEntropyFromCoinFlips(strings.Repeat("H", 128), 128)
+
+	if (err != nil || supplied != 128) {
+		t.Error("Expected", supplied, err, "to equal", 128, nil)
+		return
+	}
+
+	mnemonic, _ := gobip39.GetMnemonicFromEntropy(ent)
+	sentence, _ := mnemonic.GetSentenceFrom(wordlist.English)
+	expected := strings.Repeat("zoo ", 11) + "wrong"
+
+	if (strings.Join(sentence, " ") != expected) {
+		t.Error("Expected", strings.Join(sentence, " "), "to equal", expected)
+	}
+}
+
+func TestDice_GetEntropyFromCoinFlips_AcceptsBinary(t *testing.T) {
+	heads, _, _ := gobip39.GetEntropyFromCoinFlips(strings.Repeat("HT", 80), 160)
+	binary, _, _ := gobip39.GetEntropyFromCoinFlips(strings.Repeat("10", 80), 160)
+
+	if (len(heads.Data) != 20 || !bytes.Equal(heads.Data, binary.Data)) {
+		t.Error("Expected", heads.Data, "to equal", binary.Data)
+	}
+}
+
+func TestDice_GetEntropyFromCoinFlips_FailsOnTooFewFlips(t *testing.T) {
+	_, supplied, err := gobip39.GetEntropyFromCoinFlips(strings.Repeat("T", 127), 128)
+
+	if (!isEntropyError(err) || supplied != 127) {
+		t.Error("Expected", supplied, err, "to equal", 127, ENTROPY_ERROR)
+	}
+
+	if _, _, err := gobip39.GetEntropyFromCoinFlips(strings.Repeat("T", 128), 129); !isEntropyError(err) {
+		t.Error("Expected", err, "to be an", ENTROPY_ERROR)
+	}
+}
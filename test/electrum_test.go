@@ -0,0 +1,117 @@
+package test
+
+import (
+	"testing"
+	"encoding/hex"
+	"strings"
+	"gobip39/electrum"
+	"gobip39/wordlist"
+)
+
+// Test vectors from Electrum's test_mnemonic.py
+const ELECTRUM_SEGWIT_SENTENCE = "wild father tree among universe such mobile favorite target dynamic credit identify"
+const ELECTRUM_STANDARD_SENTENCE = "cram swing cover prefer miss modify ritual silly deliver chunk behind inform able"
+
+func TestElectrum_GetSeedType(t *testing.T) {
+	expected := map[string]electrum.SeedType{
+		ELECTRUM_SEGWIT_SENTENCE: electrum.Segwit,
+		ELECTRUM_STANDARD_SENTENCE: electrum.Standard,
+		"frost pig brisk excite novel report camera enlist axis nation novel desert": electrum.Segwit,
+		// Case and spacing do not matter
+		"  Wild FATHER tree among universe such mobile favorite target dynamic credit   identify ": electrum.Segwit,
+	}
+
+	for sentence, seedType := range expected {
+		if actual, err := electrum.GetSeedType(sentence); err != nil || actual != seedType {
+			t.Error("Expected seed type", actual, "of", sentence, "to equal", seedType)
+		}
+	}
+
+	if (electrum.IsSeed(ABANDON_SENTENCE)) {
+		t.Error("Expected", ABANDON_SENTENCE, "not to be an Electrum seed.")
+	}
+}
+
+func TestElectrum_GetSeedType_LimitsTwoFactorWordCount(t *testing.T) {
+	// Both sentences have a TwoFactor version
+	twelveWords := "prevent monitor pizza view tone cram pluck credit obey moment panther leopard"
+	thirteenWords := "acid clump bench parrot match random copper decrease expose rigid stick drift since"
+
+	if actual, err := electrum.GetSeedType(twelveWords); err != nil || actual != electrum.TwoFactor {
+		t.Error("Expected seed type", actual, "of", twelveWords, "to equal", electrum.TwoFactor)
+	}
+
+	if actual, err := electrum.GetSeedType(thirteenWords); err == nil {
+		t.Error("Expected", thirteenWords, "not to be an Electrum seed, got", actual)
+	}
+}
+
+func TestElectrum_GenerateBinarySeed(t *testing.T) {
+	cases := []struct {
+		passphrase []string
+		seed string
+	}{
+		{nil, "aac2a6302e48577ab4b46f23dbae0774e2e62c796f797d0a1b5faeb528301e3064342dafb79069e7c4c6b8c38ae11d7a973bec0d4f70626f8cc5184a8d0b0756"},
+		{[]string{"Did you ever hear the tragedy of Darth Plagueis the Wise?"}, "4aa29f2aeb0127efb55138ab9e7be83b36750358751906f86c662b21a1ea1370f949e6d1a12fa56d3d93cadda93038c76ac8118597364e46f5156fde6183c82f"},
+	}
+
+	for _, c := range cases {
+		if actual := hex.EncodeToString(electrum.GenerateBinarySeed(ELECTRUM_SEGWIT_SENTENCE, c.passphrase...)); actual != c.seed {
+			t.Error("Expected seed", actual, "to equal", c.seed)
+		}
+	}
+}
+
+func TestElectrum_GetBIP39Mnemonic_RefusesElectrumSeeds(t *testing.T) {
+	_, err := electrum.GetBIP39Mnemonic(ELECTRUM_SEGWIT_SENTENCE, wordlist.English)
+
+	if seedErr, ok := err.(electrum.SeedError); !ok || seedErr.Type != electrum.Segwit {
+		t.Error("Expected a SeedError for a segwit seed, got", err)
+	}
+
+	if _, err := electrum.GetBIP39Mnemonic(ABANDON_SENTENCE, wordlist.English); err != nil {
+		t.Error("Expected", ABANDON_SENTENCE, "to be read as BIP-0039:", err.Error())
+	}
+}
+
+func TestElectrum_GetBIP39Mnemonic_ReportsAmbiguousSentences(t *testing.T) {
+	// A valid BIP-0039 sentence whose version happens to be "01"
+	sentence := "sweet inhale slice gown airport february dinosaur kitchen couch enjoy vast clever"
+	mnemonic, err := electrum.GetBIP39Mnemonic(sentence, wordlist.English)
+	ambiguous, ok := err.(electrum.AmbiguousSeedError)
+
+	if (!ok || ambiguous.Type != electrum.Standard) {
+		t.Error("Expected", err, "to be an AmbiguousSeedError for a standard seed")
+		return
+	}
+
+	if (len(mnemonic.Sentence) != 12 || hex.EncodeToString(ambiguous.Mnemonic.Entropy.Data) != hex.EncodeToString(mnemonic.Entropy.Data)) {
+		t.Error("Expected", ambiguous.Mnemonic, "to equal", mnemonic)
+	}
+}
+
+func TestElectrum_Generate_ProducesSeedOfType(t *testing.T) {
+	for _, seedType := range []electrum.SeedType{electrum.Standard, electrum.Segwit} {
+		sentence, err := electrum.Generate(seedType)
+
+		if (err != nil) {
+			t.Fatal("Failed to generate", seedType, "seed:", err.Error())
+		}
+
+		if (len(strings.Fields(sentence)) != 12) {
+			t.Error("Expected", sentence, "to have 12 words.")
+		}
+
+		if actual, _ := electrum.GetSeedType(sentence); actual != seedType {
+			t.Error("Expected generated seed type", actual, "to equal", seedType)
+		}
+
+		if _, err := electrum.GetBIP39Mnemonic(sentence, wordlist.English); err == nil {
+			t.Error("Expected generated seed", sentence, "to be refused as BIP-0039.")
+		}
+	}
+
+	if _, err := electrum.Generate("old"); err == nil {
+		t.Error("Expected generating an unknown seed type to fail.")
+	}
+}
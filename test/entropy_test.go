@@ -6,6 +6,9 @@ import (
 	"reflect"
 	"bytes"
 	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
 )
 
 // Name of entropyError
@@ -153,6 +156,53 @@ func TestEntropy_GenerateChecksum_ReturnsByteHoldingChecksum(t *testing.T) {
 	}
 }
 
+func TestEntropy_GenerateEntropyFrom_IsReproducible(t *testing.T) {
+	first, err := gobip39.GenerateEntropyFrom(256, gobip39.NewDeterministicReader([]byte("gobip39")))
+	second, _ := gobip39.GenerateEntropyFrom(256, gobip39.NewDeterministicReader([]byte("gobip39")))
+	expected := "28f305508cf0347b3fdfec7d73ed220255d129a57ae29a22554e37ba83cc7fa5"
+
+	if (err != nil || hex.EncodeToString(first.Data) != expected) {
+		t.Error("Expected", hex.EncodeToString(first.Data), err, "to equal", expected)
+	}
+
+	if (!bytes.Equal(first.Data, second.Data)) {
+		t.Error("Expected", first.Data, "to equal", second.Data)
+	}
+}
+
+func TestEntropy_DeterministicReader_ContinuesAcrossBlocks(t *testing.T) {
+	reader := gobip39.NewDeterministicReader([]byte("gobip39"))
+	data := make([]byte, 48)
+	reader.Read(data[:20])
+	reader.Read(data[20:])
+	expected := "28f305508cf0347b3fdfec7d73ed220255d129a57ae29a22554e37ba83cc7fa5c0323dae8d06304e40390a25c7e766df"
+
+	if (hex.EncodeToString(data) != expected) {
+		t.Error("Expected", hex.EncodeToString(data), "to equal", expected)
+	}
+}
+
+func TestEntropy_GenerateEntropyFrom_FailsOnShortRead(t *testing.T) {
+	source := io.LimitReader(gobip39.NewDeterministicReader([]byte("gobip39")), 15)
+
+	if _, err := gobip39.GenerateEntropyFrom(128, source); !isEntropyError(err) {
+		t.Error("Expected GenerateEntropyFrom to return an entropyError when the source runs short.")
+	}
+}
+
+func TestEntropy_GenerateEntropyFrom_FailsOnSourceError(t *testing.T) {
+	if _, err := gobip39.GenerateEntropyFrom(128, failingReader{}); !isEntropyError(err) {
+		t.Error("Expected GenerateEntropyFrom to return an entropyError when the source fails.")
+	}
+}
+
+// Randomness source that always fails
+type failingReader struct{}
+
+func (failingReader) Read(data []byte) (int, error) {
+	return 0, errors.New("randomness source is unavailable")
+}
+
 func isEntropyError(e error) bool {
 	if (e == nil) {
 		return false
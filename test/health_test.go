@@ -0,0 +1,114 @@
+package test
+
+import (
+	"testing"
+	"bytes"
+	"errors"
+	"io"
+	"gobip39"
+)
+
+// Source cycling through 0, 1, 2, 3: never repeating, but heavily biased
+type cyclingReader struct {
+	next byte
+}
+
+func (reader *cyclingReader) Read(data []byte) (int, error) {
+	for i := range data {
+		data[i] = reader.next
+		reader.next = (reader.next + 1) % 4
+	}
+
+	return len(data), nil
+}
+
+func TestHealth_GenerateEntropyFrom_FailsOnStuckSource(t *testing.T) {
+	_, err := gobip39.GenerateEntropyFrom(128, bytes.NewReader(make([]byte, 2048)))
+	var healthErr gobip39.HealthTestError
+
+	if (!errors.As(err, &healthErr) || healthErr.Test != "Repetition count") {
+		t.Error("Expected", err, "to be a repetition count HealthTestError")
+	}
+}
+
+func TestHealth_NewHealthTestedReader_FailsStartupOnBiasedSource(t *testing.T) {
+	_, err := gobip39.NewHealthTestedReader(&cyclingReader{})
+	var healthErr gobip39.HealthTestError
+
+	if (!errors.As(err, &healthErr) || healthErr.Test != "Adaptive proportion") {
+		t.Error("Expected", err, "to be an adaptive proportion HealthTestError")
+	}
+}
+
+func TestHealth_HealthTestedReader_TestsContinuouslyAcrossGenerations(t *testing.T) {
+	// Passes the startup test, then turns biased
+	source := io.MultiReader(bytes.NewReader(deterministicBytes(gobip39.HealthTestStartupSamples)), &cyclingReader{})
+	reader, err := gobip39.NewHealthTestedReader(source)
+
+	if (err != nil) {
+		t.Error("Expected", err, "to equal", nil)
+		return
+	}
+
+	// No single 32-byte read is biased enough, but the reader's windows are
+	for i := 0; i < gobip39.AdaptiveProportionWindow / 32; i++ {
+		if _, err = gobip39.GenerateEntropyFrom(256, reader); err != nil { break }
+	}
+
+	var healthErr gobip39.HealthTestError
+
+	if (!errors.As(err, &healthErr) || healthErr.Test != "Adaptive proportion") {
+		t.Error("Expected", err, "to be an adaptive proportion HealthTestError")
+	}
+}
+
+func TestHealth_GenerateEntropyFrom_ReadsOnlyTheEntropy(t *testing.T) {
+	data := deterministicBytes(32)
+	ent, err := gobip39.GenerateEntropyFrom(256, bytes.NewReader(data))
+
+	if (err != nil || !bytes.Equal(ent.Data, data)) {
+		t.Error("Expected", ent.Data, err, "to equal", data)
+	}
+}
+
+func TestHealth_GenerateMnemonicFrom_ReturnsHealthTestError(t *testing.T) {
+	_, err := gobip39.GenerateMnemonicFrom(128, bytes.NewReader(make([]byte, 2048)))
+
+	if _, ok := err.(gobip39.HealthTestError); !ok {
+		t.Error("Expected", err, "to be a HealthTestError")
+	}
+}
+
+func TestHealth_GenerateEntropy_PassesSystemSource(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if _, err := gobip39.GenerateEntropy(256); err != nil {
+			t.Error("Expected", err, "to equal", nil)
+		}
+	}
+}
+
+func TestHealth_HealthTestedReader_FailsClosed(t *testing.T) {
+	data := append(deterministicBytes(gobip39.HealthTestStartupSamples), bytes.Repeat([]byte{7}, gobip39.RepetitionCountCutoff)...)
+	data = append(data, deterministicBytes(64)...)
+	reader, err := gobip39.NewHealthTestedReader(bytes.NewReader(data))
+
+	if (err != nil) {
+		t.Error("Expected", err, "to equal", nil)
+		return
+	}
+
+	if _, err := reader.Read(make([]byte, gobip39.RepetitionCountCutoff)); err == nil {
+		t.Error("Expected the repeated bytes to fail the repetition count test.")
+	}
+
+	if read, err := reader.Read(make([]byte, 64)); err == nil || read != 0 {
+		t.Error("Expected", read, err, "to stay failed after a failure")
+	}
+}
+
+func deterministicBytes(size int) []byte {
+	data := make([]byte, size)
+	gobip39.NewDeterministicReader([]byte("gobip39")).Read(data)
+
+	return data
+}
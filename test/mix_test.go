@@ -0,0 +1,67 @@
+package test
+
+import (
+	"testing"
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"gobip39"
+)
+
+var MIX_RANDOM = []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F}
+
+func TestMix_MixEntropy_XORsInputs(t *testing.T) {
+	user := bytes.Repeat([]byte{0xFF}, 16)
+	ent, err := gobip39.MixEntropy(MIX_RANDOM, user, gobip39.MixXOR)
+	expected := "fffefdfcfbfaf9f8f7f6f5f4f3f2f1f0"
+
+	if (err != nil || hex.EncodeToString(ent.Data) != expected) {
+		t.Error("Expected", hex.EncodeToString(ent.Data), err, "to equal", expected)
+	}
+}
+
+func TestMix_MixEntropy_HMACsInputs(t *testing.T) {
+	ent, err := gobip39.MixEntropy(MIX_RANDOM, []byte("123456"), gobip39.MixHMAC)
+	expected := "057a646e2f137df38bf51388f2f8a3b8"
+
+	if (err != nil || hex.EncodeToString(ent.Data) != expected) {
+		t.Error("Expected", hex.EncodeToString(ent.Data), err, "to equal", expected)
+	}
+}
+
+func TestMix_MixEntropy_FailsOnMismatchedXOR(t *testing.T) {
+	if _, err := gobip39.MixEntropy(MIX_RANDOM, []byte{1, 2, 3}, gobip39.MixXOR); !isEntropyError(err) {
+		t.Error("Expected", err, "to be an", ENTROPY_ERROR)
+	}
+
+	if _, err := gobip39.MixEntropy(MIX_RANDOM, []byte{}, gobip39.MixHMAC); !isEntropyError(err) {
+		t.Error("Expected", err, "to be an", ENTROPY_ERROR)
+	}
+}
+
+func TestMix_GenerateMixedEntropy_TranscriptReproducesEntropy(t *testing.T) {
+	dice, _, _ := gobip39.GetEntropyFromDiceRolls(strings.Repeat("1234", 32), 256)
+
+	for _, mode := range []gobip39.MixMode{gobip39.MixXOR, gobip39.MixHMAC} {
+		ent, transcript, err := gobip39.GenerateMixedEntropy(256, dice.Data, mode)
+
+		if (err != nil || ent.Size != 256) {
+			t.Error("Expected", ent.Size, err, "to equal", 256, nil)
+			continue
+		}
+
+		if (!bytes.Equal(transcript.User, dice.Data) || len(transcript.Random) != 32) {
+			t.Error("Expected", transcript, "to record its inputs")
+		}
+
+		if err := transcript.Verify(); err != nil {
+			t.Error("Expected", err, "to equal", nil)
+		}
+
+		transcript.User[0] ^= 1
+
+		if err := transcript.Verify(); !isEntropyError(err) {
+			t.Error("Expected", err, "to be an", ENTROPY_ERROR)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package test
+
+import (
+	"testing"
+	"io/ioutil"
+	"encoding/json"
+	"encoding/hex"
+	"gobip39"
+	"gobip39/wordlist"
+	"strings"
+	"bytes"
+)
+
+func TestMnemonic_GetMnemonicFromSentence_MatchesEnglishVectors(t *testing.T) {
+	file, err := ioutil.ReadFile("./vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'vectors.json':", err.Error())
+	}
+
+	var vectors struct {
+		Vectors []Vector `json:"english"`
+	}
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	for _, v := range vectors.Vectors {
+		mnemonic, mnemonicErr := gobip39.GetMnemonicFromSentence(strings.Split(v[1], " "), wordlist.English)
+
+		if (mnemonicErr != nil) {
+			t.Error("Failed to get Mnemonic from sentence", v[1], "\b:", mnemonicErr.Error())
+			continue
+		}
+
+		if actual := hex.EncodeToString(mnemonic.Entropy.Data); actual != v[0] {
+			t.Error("Expected entropy", actual, "to equal", v[0])
+		}
+	}
+}
+
+func TestMnemonic_GetMnemonicFromSentence_FailsOnInvalidChecksum(t *testing.T) {
+	// The last word of the all-zero 12 word vector is "about"
+	sentence := strings.Split(strings.Replace(ABANDON_SENTENCE, "about", "abandon", 1), " ")
+
+	if _, err := gobip39.GetMnemonicFromSentence(sentence, wordlist.English); err == nil {
+		t.Error("Expected GetMnemonicFromSentence to return an error when the checksum does not match.")
+	}
+}
+
+func TestMnemonic_GetMnemonicFromSentence_FailsOnUnknownWord(t *testing.T) {
+	sentence := strings.Split(strings.Replace(ABANDON_SENTENCE, "about", "aboot", 1), " ")
+
+	if _, err := gobip39.GetMnemonicFromSentence(sentence, wordlist.English); err == nil {
+		t.Error("Expected GetMnemonicFromSentence to return an error for a word outside the wordlist.")
+	}
+}
+
+func TestMnemonic_GetMnemonicFromSentence_FailsOnInvalidLength(t *testing.T) {
+	sentence := strings.Split(ABANDON_SENTENCE, " ")[:11]
+
+	if _, err := gobip39.GetMnemonicFromSentence(sentence, wordlist.English); err == nil {
+		t.Error("Expected GetMnemonicFromSentence to return an error for 11 words.")
+	}
+}
+
+func TestMnemonic_GetMnemonicFromSentence_RoundTripsGeneratedMnemonic(t *testing.T) {
+	for _, size := range []uint16{128, 160, 192, 224, 256} {
+		generated, _ := gobip39.GenerateMnemonic(size)
+		sentence, _ := generated.GetSentenceFrom(wordlist.English)
+
+		parsed, err := gobip39.GetMnemonicFromSentence(sentence, wordlist.English)
+
+		if (err != nil || !bytes.Equal(parsed.Entropy.Data, generated.Entropy.Data)) {
+			t.Error("Expected sentence of", size, "bit Mnemonic to parse back into its entropy.")
+		}
+	}
+}
+
+func TestMnemonic_GetMnemonicFromIndices_FailsOnOutOfRangeIndex(t *testing.T) {
+	indices := make([]uint32, 12)
+	indices[11] = 2048
+
+	if _, err := gobip39.GetMnemonicFromIndices(indices); err == nil {
+		t.Error("Expected GetMnemonicFromIndices to return an error for an index above 2047.")
+	}
+}
+
+func TestMnemonic_GenerateMnemonicFrom_IsReproducible(t *testing.T) {
+	first, err := gobip39.GenerateMnemonicFrom(128, gobip39.NewDeterministicReader([]byte("gobip39")))
+	second, _ := gobip39.GenerateMnemonicFrom(128, gobip39.NewDeterministicReader([]byte("gobip39")))
+
+	if (err != nil || hex.EncodeToString(first.Entropy.Data) != "28f305508cf0347b3fdfec7d73ed2202") {
+		t.Error("Expected GenerateMnemonicFrom to read its entropy from the source, got", first.Entropy.Data, err)
+	}
+
+	if (!bytes.Equal(first.Entropy.Data, second.Entropy.Data) || len(first.Sentence) != 12) {
+		t.Error("Expected", first.Sentence, "to equal", second.Sentence)
+	}
+}
+
+func TestMnemonic_GenerateMnemonicFrom_FailsOnSourceError(t *testing.T) {
+	if _, err := gobip39.GenerateMnemonicFrom(128, failingReader{}); err == nil {
+		t.Error("Expected GenerateMnemonicFrom to return an error when the source fails.")
+	}
+}
+
+func TestMnemonic_GetMnemonicFromSentence_UsesWordlistSearch(t *testing.T) {
+	mnemonic, _ := gobip39.GetMnemonicFromBytes(bytes.Repeat([]byte{0x7F}, 16))
+	sentence, _ := mnemonic.GetSentenceFrom(reversedEnglish{})
+	decoded, err := gobip39.GetMnemonicFromSentence(sentence, reversedEnglish{})
+
+	if (err != nil || !bytes.Equal(decoded.Entropy.Data, mnemonic.Entropy.Data)) {
+		t.Error("Expected", decoded.Entropy.Data, err, "to equal", mnemonic.Entropy.Data)
+	}
+}
+
+// English words in reverse order, so the list is not sorted
+type reversedEnglish struct{}
+
+func (reversedEnglish) Language() string {
+	return "Reversed English"
+}
+
+func (reversedEnglish) Words() ([wordlist.WordlistSize]string, error) {
+	words, err := wordlist.English.Words()
+
+	for i, j := 0, len(words) - 1; i < j; i, j = i + 1, j - 1 {
+		words[i], words[j] = words[j], words[i]
+	}
+
+	return words, err
+}
+
+func (wl reversedEnglish) GetWordAt(index uint32) (string, error) {
+	return wordlist.English.GetWordAt(wordlist.WordlistSize - 1 - index)
+}
+
+func (wl reversedEnglish) FindWord(word string) int {
+	index := wordlist.English.FindWord(word)
+
+	if (index < 0) { return -1 }
+
+	return wordlist.WordlistSize - 1 - index
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"testing"
+	"io/ioutil"
+	"encoding/json"
+	"encoding/hex"
+	"gobip39"
+	"gobip39/nostr"
+	"gobip39/wordlist"
+)
+
+type nip06Vector struct {
+	Sentence string `json:"sentence"`
+	PrivateKey string `json:"privateKey"`
+	Nsec string `json:"nsec"`
+	PublicKey string `json:"publicKey"`
+	Npub string `json:"npub"`
+}
+
+func TestNostr_NIP06Vectors(t *testing.T) {
+	file, err := ioutil.ReadFile("./nostr_vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'nostr_vectors.json':", err.Error())
+	}
+
+	var vectors struct {
+		Vectors []nip06Vector `json:"nip06"`
+	}
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	for _, v := range vectors.Vectors {
+		keys, deriveErr := nostr.DeriveKeys(gobip39.GenerateBinarySeed(v.Sentence), 0)
+
+		if (deriveErr != nil) {
+			t.Error("Failed to derive Nostr keys:", deriveErr.Error())
+			continue
+		}
+
+		if privateKey := hex.EncodeToString(keys.PrivateKey[:]); privateKey != v.PrivateKey {
+			t.Error("Expected private key", privateKey, "to equal", v.PrivateKey)
+		}
+
+		if publicKey := hex.EncodeToString(keys.PublicKey[:]); publicKey != v.PublicKey {
+			t.Error("Expected public key", publicKey, "to equal", v.PublicKey)
+		}
+
+		if nsec, _ := keys.Nsec(); nsec != v.Nsec {
+			t.Error("Expected nsec", nsec, "to equal", v.Nsec)
+		}
+
+		if npub, _ := keys.Npub(); npub != v.Npub {
+			t.Error("Expected npub", npub, "to equal", v.Npub)
+		}
+
+		if decoded, decodeErr := nostr.DecodeKey(v.Npub, nostr.PublicKeyPrefix); decodeErr != nil || decoded != keys.PublicKey {
+			t.Error("Expected", v.Npub, "to decode to the derived public key.")
+		}
+	}
+}
+
+func TestNostr_DecodeKey_FailsOnWrongPrefix(t *testing.T) {
+	keys, _ := nostr.DeriveKeys(gobip39.GenerateBinarySeed(ABANDON_SENTENCE), 0)
+	npub, _ := keys.Npub()
+
+	if _, err := nostr.DecodeKey(npub, nostr.PrivateKeyPrefix); err == nil {
+		t.Error("Expected DecodeKey to return an error when decoding an npub as an nsec.")
+	}
+}
+
+func TestNostr_DeriveKeysFromMnemonic_MatchesSeedDerivation(t *testing.T) {
+	mnemonic, _ := gobip39.GetMnemonicFromBytes(make([]byte, 16))
+
+	fromMnemonic, err := nostr.DeriveKeysFromMnemonic(mnemonic, wordlist.English, 0)
+
+	if (err != nil) {
+		t.Fatal("Failed to derive Nostr keys from Mnemonic:", err.Error())
+	}
+
+	fromSeed, _ := nostr.DeriveKeys(gobip39.GenerateBinarySeed(ABANDON_SENTENCE), 0)
+
+	if (fromMnemonic != fromSeed) {
+		t.Error("Expected keys derived from the Mnemonic to equal keys derived from its seed.")
+	}
+}
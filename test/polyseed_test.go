@@ -0,0 +1,148 @@
+package test
+
+import (
+	"testing"
+	"encoding/hex"
+	"strings"
+	"time"
+	"gobip39/polyseed"
+	"gobip39/wordlist"
+)
+
+// English phrase of Polyseed's test suite
+const POLYSEED_SENTENCE = "raven tail swear infant grief assist regular lamp duck valid someone little harsh puppy airport language"
+const POLYSEED_SECRET = "dd76e7359a0ded37cd0ff0f3c829a5ae016733"
+
+func TestPolyseed_Decode_MatchesVector(t *testing.T) {
+	seed, err := polyseed.Decode(strings.Fields(POLYSEED_SENTENCE), wordlist.English, polyseed.Monero)
+
+	if (err != nil) {
+		t.Error("Expected", err, "to equal", nil)
+		return
+	}
+
+	if (hex.EncodeToString(seed.Secret[:]) != POLYSEED_SECRET) {
+		t.Error("Expected", hex.EncodeToString(seed.Secret[:]), "to equal", POLYSEED_SECRET)
+	}
+
+	if (seed.Birthday != 1 || seed.Features != 0) {
+		t.Error("Expected", seed.Birthday, seed.Features, "to equal", 1, 0)
+	}
+
+	sentence, _ := seed.GetSentence(wordlist.English, polyseed.Monero)
+
+	if (strings.Join(sentence, " ") != POLYSEED_SENTENCE) {
+		t.Error("Expected", strings.Join(sentence, " "), "to equal", POLYSEED_SENTENCE)
+	}
+}
+
+func TestPolyseed_Generate_RoundTrips(t *testing.T) {
+	birthday := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)
+	seed, err := polyseed.Generate(birthday, 0x05)
+
+	if (err != nil) {
+		t.Error("Expected", err, "to equal", nil)
+		return
+	}
+
+	for _, wl := range []wordlist.Wordlist{wordlist.English, reversedEnglish{}} {
+		sentence, _ := seed.GetSentence(wl, polyseed.Wownero)
+		decoded, err := polyseed.DecodeWithFeatures(sentence, wl, polyseed.Wownero, 0x05)
+
+		if (err != nil || decoded != seed) {
+			t.Error("Expected", decoded, err, "to equal", seed)
+		}
+	}
+
+	if (seed.BirthdayTime().After(birthday) || birthday.Sub(seed.BirthdayTime()) >= polyseed.TimeStep * time.Second) {
+		t.Error("Expected", seed.BirthdayTime(), "to be the time step of", birthday)
+	}
+}
+
+func TestPolyseed_Generate_RejectsReservedFeatures(t *testing.T) {
+	if _, err := polyseed.Generate(time.Now(), polyseed.EncryptedFeature); err == nil {
+		t.Error("Expected", err, "to not equal", nil)
+	}
+}
+
+func TestPolyseed_Decode_RejectsUserFeaturesUnlessEnabled(t *testing.T) {
+	seed := polyseed.Seed{Birthday: 1, Features: 0x02}
+	sentence, _ := seed.GetSentence(wordlist.English, polyseed.Monero)
+
+	if _, err := polyseed.Decode(sentence, wordlist.English, polyseed.Monero); err == nil {
+		t.Error("Expected", err, "to not equal", nil)
+	}
+
+	if _, err := polyseed.DecodeWithFeatures(sentence, wordlist.English, polyseed.Monero, 0x01); err == nil {
+		t.Error("Expected", err, "to not equal", nil)
+	}
+
+	if decoded, err := polyseed.DecodeWithFeatures(sentence, wordlist.English, polyseed.Monero, 0x02); err != nil || decoded != seed {
+		t.Error("Expected", decoded, err, "to equal", seed)
+	}
+}
+
+func TestPolyseed_Decode_RejectsOtherCoin(t *testing.T) {
+	_, err := polyseed.Decode(strings.Fields(POLYSEED_SENTENCE), wordlist.English, polyseed.Aeon)
+
+	if (err != polyseed.ErrInvalidChecksum) {
+		t.Error("Expected", err, "to equal", polyseed.ErrInvalidChecksum)
+	}
+}
+
+func TestPolyseed_Decode_DetectsSubstitution(t *testing.T) {
+	words := strings.Fields(POLYSEED_SENTENCE)
+
+	for i := range words {
+		changed := append([]string{}, words...)
+		changed[i] = "abandon"
+
+		if _, err := polyseed.Decode(changed, wordlist.English, polyseed.Monero); err != polyseed.ErrInvalidChecksum {
+			t.Error("Expected", err, "to equal", polyseed.ErrInvalidChecksum)
+		}
+	}
+}
+
+// Monero key of POLYSEED_SENTENCE: PBKDF2-HMAC-SHA256 with 10000 iterations
+// of the secret, salted with "POLYSEED key", a zero byte, three 0xFF bytes
+// and the little-endian coin, birthday and features, computed independently
+// with Python's hashlib
+const POLYSEED_MONERO_KEY = "21268a76048a3b25a4a9ac179d86b12fab5800b8d858da9facf4b0a778dc2840"
+
+func TestPolyseed_Key_MatchesVector(t *testing.T) {
+	seed, _ := polyseed.Decode(strings.Fields(POLYSEED_SENTENCE), wordlist.English, polyseed.Monero)
+	key, err := seed.Key(polyseed.Monero, 32)
+
+	if (err != nil || hex.EncodeToString(key) != POLYSEED_MONERO_KEY) {
+		t.Error("Expected", hex.EncodeToString(key), err, "to equal", POLYSEED_MONERO_KEY)
+	}
+}
+
+func TestPolyseed_Key_SeparatesCoins(t *testing.T) {
+	seed, _ := polyseed.Decode(strings.Fields(POLYSEED_SENTENCE), wordlist.English, polyseed.Monero)
+	monero, err := seed.Key(polyseed.Monero, 32)
+	again, _ := seed.Key(polyseed.Monero, 32)
+	aeon, _ := seed.Key(polyseed.Aeon, 32)
+
+	if (err != nil || len(monero) != 32 || hex.EncodeToString(monero) != hex.EncodeToString(again)) {
+		t.Error("Expected", hex.EncodeToString(monero), err, "to be a deterministic 32-byte key")
+	}
+
+	if (hex.EncodeToString(monero) == hex.EncodeToString(aeon)) {
+		t.Error("Expected", hex.EncodeToString(monero), "to differ between coins")
+	}
+}
+
+func TestPolyseed_Key_FailsOnEncryptedSecret(t *testing.T) {
+	seed := polyseed.Seed{Birthday: 1, Features: polyseed.EncryptedFeature}
+	sentence, _ := seed.GetSentence(wordlist.English, polyseed.Monero)
+	decoded, err := polyseed.Decode(sentence, wordlist.English, polyseed.Monero)
+
+	if (err != nil || !decoded.IsEncrypted()) {
+		t.Error("Expected", decoded, err, "to be an encrypted seed")
+	}
+
+	if key, err := decoded.Key(polyseed.Monero, 32); err != polyseed.ErrEncrypted || key != nil {
+		t.Error("Expected", key, err, "to equal", nil, polyseed.ErrEncrypted)
+	}
+}
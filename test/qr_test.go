@@ -0,0 +1,132 @@
+package test
+
+import (
+	"testing"
+	"bytes"
+	"image/png"
+	"strings"
+	"gobip39/qr"
+)
+
+func TestQR_Encode_ChoosesSmallestVersion(t *testing.T) {
+	cases := []struct {
+		text string
+		level qr.Level
+		version int
+	}{
+		{"HELLO WORLD", qr.Quartile, 1},
+		// Version 1 holds 41 digits, 25 alphanumeric characters or 17 bytes at level L
+		{strings.Repeat("7", 41), qr.Low, 1},
+		{strings.Repeat("7", 42), qr.Low, 2},
+		{strings.Repeat("A", 25), qr.Low, 1},
+		{strings.Repeat("A", 26), qr.Low, 2},
+		{strings.Repeat("a", 17), qr.Low, 1},
+		{strings.Repeat("a", 17), qr.High, 3},
+		// Version 40 holds 2953 bytes at level L
+		{strings.Repeat("a", 2953), qr.Low, 40},
+	}
+
+	for _, c := range cases {
+		code, err := qr.Encode(c.text, c.level)
+
+		if (err != nil) {
+			t.Fatal("Failed to encode", len(c.text), "characters:", err.Error())
+		}
+
+		if (code.Version != c.version || code.Size != c.version * 4 + 17) {
+			t.Error("Expected version", code.Version, "of", len(c.text), "characters to equal", c.version)
+		}
+	}
+}
+
+func TestQR_Encode_FailsWhenTooLong(t *testing.T) {
+	if _, err := qr.Encode(strings.Repeat("a", 2954), qr.Low); err == nil {
+		t.Error("Expected 2954 bytes not to fit in a QR code.")
+	}
+}
+
+func TestQR_Encode_DrawsFinderPatterns(t *testing.T) {
+	code, _ := qr.Encode("ur:crypto-seed", qr.Medium)
+
+	// Each finder is a dark ring, a light ring and a dark 3x3 centre
+	for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+		for _, offset := range [][3]int{{0, 0, 1}, {6, 6, 1}, {1, 1, 0}, {5, 1, 0}, {2, 2, 1}, {3, 3, 1}} {
+			if dark := code.Dark(corner[0] + offset[0], corner[1] + offset[1]); dark != (offset[2] == 1) {
+				t.Error("Expected finder module", corner, offset, "dark to equal", offset[2] == 1)
+			}
+		}
+	}
+
+	if (code.Dark(-1, 0) || code.Dark(code.Size, 0)) {
+		t.Error("Expected modules outside the code to be light.")
+	}
+}
+
+func TestQR_ParseLevel(t *testing.T) {
+	expected := map[string]qr.Level{"L": qr.Low, "m": qr.Medium, "Q": qr.Quartile, "h": qr.High}
+
+	for name, level := range expected {
+		if parsed, err := qr.ParseLevel(name); err != nil || parsed != level {
+			t.Error("Expected level", name, "to parse to", level)
+		}
+	}
+
+	if _, err := qr.ParseLevel("X"); err == nil {
+		t.Error("Expected level X to fail to parse.")
+	}
+}
+
+func TestQR_PNG_ScalesModules(t *testing.T) {
+	code, _ := qr.Encode("xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", qr.Medium)
+
+	encoded, err := code.PNG(3)
+
+	if (err != nil) {
+		t.Fatal("Failed to render PNG:", err.Error())
+	}
+
+	image, decodeErr := png.Decode(bytes.NewReader(encoded))
+
+	if (decodeErr != nil) {
+		t.Fatal("Failed to decode PNG:", decodeErr.Error())
+	}
+
+	width := (code.Size + qr.QuietZone * 2) * 3
+
+	if (image.Bounds().Dx() != width || image.Bounds().Dy() != width) {
+		t.Error("Expected PNG size", image.Bounds().Size(), "to equal", width)
+	}
+
+	if _, err := code.PNG(0); err == nil {
+		t.Error("Expected PNG to fail for a scale of 0.")
+	}
+}
+
+func TestQR_Terminal_UsesHalfBlocks(t *testing.T) {
+	code, _ := qr.Encode("HELLO WORLD", qr.Quartile)
+	width := code.Size + qr.QuietZone * 2
+
+	for _, darkBackground := range []bool{false, true} {
+		lines := strings.Split(strings.TrimSuffix(code.Terminal(darkBackground), "\n"), "\n")
+
+		if (len(lines) != (width + 1) / 2) {
+			t.Error("Expected", len(lines), "lines to equal", (width + 1) / 2)
+		}
+
+		for _, line := range lines {
+			if (len([]rune(line)) != width) {
+				t.Error("Expected line", line, "to be", width, "characters wide.")
+			}
+		}
+
+		// The quiet zone is blank on light backgrounds and blocks on dark ones
+		expected := strings.Repeat(" ", width)
+		if (darkBackground) {
+			expected = strings.Repeat("█", width)
+		}
+
+		if (lines[0] != expected) {
+			t.Error("Expected the first line", lines[0], "to equal", expected)
+		}
+	}
+}
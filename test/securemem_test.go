@@ -0,0 +1,102 @@
+package test
+
+import (
+	"testing"
+	"bytes"
+	"runtime"
+	"gobip39"
+	"gobip39/securemem"
+)
+
+func skipUnlessLinux(t *testing.T) {
+	if (runtime.GOOS != "linux") {
+		t.Skip("Secure buffers are only supported on Linux.")
+	}
+}
+
+func TestSecuremem_New_AllocatesZeroedBuffer(t *testing.T) {
+	skipUnlessLinux(t)
+
+	for _, size := range []int{1, 64, 4096, 5000} {
+		buffer, err := securemem.New(size)
+
+		if (err != nil) {
+			t.Error("Expected", err, "to equal", nil)
+			continue
+		}
+
+		if (!bytes.Equal(buffer.Bytes(), make([]byte, size))) {
+			t.Error("Expected a zeroed buffer of", size, "bytes")
+		}
+
+		copy(buffer.Bytes(), bytes.Repeat([]byte{0xAA}, size))
+
+		if (buffer.Bytes()[size - 1] != 0xAA) {
+			t.Error("Expected", buffer.Bytes()[size - 1], "to equal", 0xAA)
+		}
+
+		if err := buffer.Destroy(); err != nil || buffer.Bytes() != nil {
+			t.Error("Expected", err, buffer.Bytes(), "to equal", nil, nil)
+		}
+
+		if err := buffer.Destroy(); err != nil {
+			t.Error("Expected destroying again to do nothing, got", err)
+		}
+	}
+}
+
+func TestSecuremem_New_FailsOnNonPositiveSize(t *testing.T) {
+	if _, err := securemem.New(0); err == nil {
+		t.Error("Expected", err, "to not equal", nil)
+	}
+}
+
+func TestSecuremem_GenerateSecureEntropy_FormsMnemonic(t *testing.T) {
+	skipUnlessLinux(t)
+
+	ent, buffer, err := gobip39.GenerateSecureEntropy(256)
+
+	if (err != nil) {
+		t.Error("Expected", err, "to equal", nil)
+		return
+	}
+
+	defer buffer.Destroy()
+
+	if (ent.Size != 256 || len(ent.Data) != 32 || &ent.Data[0] != &buffer.Bytes()[0]) {
+		t.Error("Expected", ent, "to be 256 bits held in the secure buffer")
+	}
+
+	if mnemonic, err := gobip39.GetMnemonicFromEntropy(ent); err != nil || len(mnemonic.Sentence) != 24 {
+		t.Error("Expected", mnemonic.Sentence, err, "to have 24 words")
+	}
+}
+
+func TestSecuremem_GenerateSecureBinarySeed_MatchesGenerateBinarySeed(t *testing.T) {
+	skipUnlessLinux(t)
+
+	// The second sentence is longer than a SHA-512 block, so HMAC hashes the key
+	sentences := []string{
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless",
+	}
+
+	for _, sentence := range sentences {
+		for _, passphrase := range []string{"TREZOR", "", "パスワード"} {
+			buffer, err := gobip39.GenerateSecureBinarySeed(sentence, passphrase)
+
+			if (err != nil) {
+				t.Error("Expected", err, "to equal", nil)
+				continue
+			}
+
+			expected := gobip39.GenerateBinarySeed(sentence, passphrase)
+
+			if (!bytes.Equal(buffer.Bytes(), expected)) {
+				t.Error("Expected", buffer.Bytes(), "to equal", expected)
+			}
+
+			buffer.Destroy()
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"testing"
+	"bytes"
+	"strings"
+	"encoding/hex"
+	"gobip39"
+	"gobip39/seedqr"
+	"gobip39/wordlist"
+)
+
+// Example from the SeedQR specification
+const SEEDQR_SENTENCE = "attack pizza motion avocado network gather crop fresh patrol unusual wild holiday candy pony ranch winter theme error hybrid van cereal salon goddess expire"
+const SEEDQR_DIGITS = "011513251154012711900771041507421289190620080870026613431420201617920614089619290300152408010643"
+const COMPACT_SEEDQR = "0e74b64107f94cc0ccfae6a13dcbec3662154fec67e0e00999c07892597d190a"
+
+func TestSeedQR_Encode_MatchesSpecExample(t *testing.T) {
+	mnemonic, _ := gobip39.GetMnemonicFromSentence(strings.Split(SEEDQR_SENTENCE, " "), wordlist.English)
+
+	if actual := seedqr.Encode(mnemonic); actual != SEEDQR_DIGITS {
+		t.Error("Expected SeedQR", actual, "to equal", SEEDQR_DIGITS)
+	}
+
+	if actual := hex.EncodeToString(seedqr.EncodeCompact(mnemonic)); actual != COMPACT_SEEDQR {
+		t.Error("Expected CompactSeedQR", actual, "to equal", COMPACT_SEEDQR)
+	}
+}
+
+func TestSeedQR_Decode_RoundTrips(t *testing.T) {
+	decoded, err := seedqr.Decode(SEEDQR_DIGITS)
+
+	if (err != nil) {
+		t.Fatal("Failed to decode SeedQR:", err.Error())
+	}
+
+	if sentence, _ := decoded.GetSentenceFrom(wordlist.English); strings.Join(sentence, " ") != SEEDQR_SENTENCE {
+		t.Error("Expected SeedQR to decode to", SEEDQR_SENTENCE)
+	}
+
+	data, _ := hex.DecodeString(COMPACT_SEEDQR)
+	compact, compactErr := seedqr.DecodeCompact(data)
+
+	if (compactErr != nil || !bytes.Equal(compact.Entropy.Data, decoded.Entropy.Data)) {
+		t.Error("Expected CompactSeedQR to decode to the same entropy.")
+	}
+}
+
+func TestSeedQR_Decode_FailsOnInvalidDigits(t *testing.T) {
+	invalid := []string{
+		SEEDQR_DIGITS[:len(SEEDQR_DIGITS) - 1],
+		// Last word changed, breaking the checksum
+		SEEDQR_DIGITS[:len(SEEDQR_DIGITS) - 4] + "0644",
+		"01x5" + SEEDQR_DIGITS[4:],
+	}
+
+	for _, digits := range invalid {
+		if _, err := seedqr.Decode(digits); err == nil {
+			t.Error("Expected SeedQR", digits, "to fail to decode.")
+		}
+	}
+}
+
+func TestSeedQR_QR_UsesSpecVersions(t *testing.T) {
+	expected := map[uint16][2]int{128: {2, 1}, 256: {3, 2}}
+
+	for size, versions := range expected {
+		mnemonic, _ := gobip39.GenerateMnemonic(size)
+
+		standard, err := seedqr.QR(mnemonic)
+		compact, compactErr := seedqr.CompactQR(mnemonic)
+
+		if (err != nil || compactErr != nil) {
+			t.Fatal("Failed to generate SeedQR codes for", size, "bits.")
+		}
+
+		if (standard.Version != versions[0] || compact.Version != versions[1]) {
+			t.Error("Expected", size, "bit SeedQR versions", standard.Version, compact.Version, "to equal", versions)
+		}
+	}
+}
+
+func TestSeedQR_QR_RendersPNGAndSVG(t *testing.T) {
+	mnemonic, _ := gobip39.GetMnemonicFromSentence(strings.Split(SEEDQR_SENTENCE, " "), wordlist.English)
+	code, _ := seedqr.QR(mnemonic)
+
+	image, err := code.PNG(4)
+
+	if (err != nil || !bytes.HasPrefix(image, []byte("\x89PNG\r\n\x1a\n"))) {
+		t.Error("Expected SeedQR to render as a PNG.")
+	}
+
+	if svg := code.SVG(); !strings.HasPrefix(svg, "<svg") || !strings.Contains(svg, `viewBox="0 0 37 37"`) {
+		t.Error("Expected SeedQR to render as a 29 module SVG with its quiet zone.")
+	}
+}
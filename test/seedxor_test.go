@@ -0,0 +1,93 @@
+package test
+
+import (
+	"testing"
+	"io/ioutil"
+	"encoding/json"
+	"strings"
+	"gobip39"
+	"gobip39/seedxor"
+	"gobip39/wordlist"
+	"bytes"
+)
+
+func TestSeedXor_ColdcardVectors(t *testing.T) {
+	file, err := ioutil.ReadFile("./seedxor_vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'seedxor_vectors.json':", err.Error())
+	}
+
+	var vectors struct {
+		Coldcard []struct {
+			Parts []string `json:"parts"`
+			Result string `json:"result"`
+		} `json:"coldcard"`
+	}
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	for _, v := range vectors.Coldcard {
+		sentences := make([][]string, len(v.Parts))
+		for i, part := range v.Parts {
+			sentences[i] = strings.Split(part, " ")
+		}
+
+		combined, combineErr := seedxor.CombineSentences(sentences, wordlist.English)
+
+		if (combineErr != nil) {
+			t.Fatal("Failed to combine Seed XOR parts:", combineErr.Error())
+		}
+
+		sentence, _ := combined.GetSentenceFrom(wordlist.English)
+
+		if joined := strings.Join(sentence, " "); joined != v.Result {
+			t.Error("Expected combined sentence", joined, "to equal", v.Result)
+		}
+	}
+}
+
+func TestSeedXor_SplitAndCombineRoundTrip(t *testing.T) {
+	for _, size := range []uint16{128, 192, 256} {
+		entropy, _ := gobip39.GenerateEntropy(size)
+
+		parts, err := seedxor.Split(entropy, 4)
+
+		if (err != nil) {
+			t.Fatal("Failed to split Entropy:", err.Error())
+		}
+
+		sentences := make([][]string, len(parts))
+		for i, part := range parts {
+			sentences[i], _ = part.GetSentenceFrom(wordlist.English)
+		}
+
+		// Every part is a valid mnemonic and order does not matter
+		sentences[0], sentences[3] = sentences[3], sentences[0]
+
+		combined, combineErr := seedxor.CombineSentences(sentences, wordlist.English)
+
+		if (combineErr != nil || !bytes.Equal(combined.Entropy.Data, entropy.Data)) {
+			t.Error("Expected", size, "bit Seed XOR parts to combine into the original Entropy.")
+		}
+	}
+}
+
+func TestSeedXor_Combine_FailsOnMixedLengths(t *testing.T) {
+	short, _ := gobip39.GenerateMnemonic(128)
+	long, _ := gobip39.GenerateMnemonic(256)
+
+	if _, err := seedxor.Combine([]gobip39.Mnemonic{short, long}); err == nil {
+		t.Error("Expected Combine to return an error when parts have different lengths.")
+	}
+}
+
+func TestSeedXor_Split_FailsOnSinglePart(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(128)
+
+	if _, err := seedxor.Split(entropy, 1); err == nil {
+		t.Error("Expected Split to return an error for a single part.")
+	}
+}
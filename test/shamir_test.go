@@ -0,0 +1,89 @@
+package test
+
+import (
+	"testing"
+	"gobip39"
+	"gobip39/shamir"
+	"gobip39/wordlist"
+	"bytes"
+)
+
+func TestShamir_Split_SharesAreValidMnemonics(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(256)
+
+	shares, err := shamir.Split(entropy, 3, 5)
+
+	if (err != nil) {
+		t.Fatal("Failed to split Entropy:", err.Error())
+	}
+
+	for _, share := range shares {
+		sentence, sentenceErr := share.Mnemonic.GetSentenceFrom(wordlist.English)
+
+		if (sentenceErr != nil || len(sentence) != 24) {
+			t.Fatal("Expected share", share.Index, "to encode as a 24 word sentence.")
+		}
+
+		// Written shares must read back as valid BIP-0039 phrases
+		parsed, parseErr := shamir.ParseShare(share.Index, share.Threshold, sentence, wordlist.English)
+
+		if (parseErr != nil || !bytes.Equal(parsed.Mnemonic.Entropy.Data, share.Mnemonic.Entropy.Data)) {
+			t.Error("Expected share", share.Index, "to parse back from its sentence.")
+		}
+	}
+}
+
+func TestShamir_Combine_RecoversFromAnyThresholdSubset(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(128)
+
+	shares, _ := shamir.Split(entropy, 3, 5)
+
+	subsets := [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}}
+
+	for _, subset := range subsets {
+		selected := []shamir.Share{}
+		for _, i := range subset {
+			selected = append(selected, shares[i])
+		}
+
+		recovered, err := shamir.Combine(selected)
+
+		if (err != nil || !bytes.Equal(recovered.Data, entropy.Data)) {
+			t.Error("Expected shares", subset, "to recover the Entropy.")
+		}
+	}
+}
+
+func TestShamir_Combine_FailsBelowThreshold(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(128)
+
+	shares, _ := shamir.Split(entropy, 3, 5)
+
+	if _, err := shamir.Combine(shares[:2]); err == nil {
+		t.Error("Expected Combine to return an error with fewer shares than the threshold.")
+	}
+}
+
+func TestShamir_Combine_DetectsInconsistentShares(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(128)
+	other, _ := gobip39.GenerateEntropy(128)
+
+	shares, _ := shamir.Split(entropy, 2, 3)
+	otherShares, _ := shamir.Split(other, 2, 3)
+
+	// A share from another split, beyond the threshold
+	if _, err := shamir.Combine([]shamir.Share{shares[0], shares[1], otherShares[2]}); err == nil {
+		t.Error("Expected Combine to return an error for a share from another split.")
+	}
+
+	if _, err := shamir.Combine([]shamir.Share{shares[0], shares[0]}); err == nil {
+		t.Error("Expected Combine to return an error for repeated share indices.")
+	}
+
+	mismatched := shares[1]
+	mismatched.Threshold = 3
+
+	if _, err := shamir.Combine([]shamir.Share{shares[0], mismatched}); err == nil {
+		t.Error("Expected Combine to return an error when shares disagree on the threshold.")
+	}
+}
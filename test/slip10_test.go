@@ -0,0 +1,233 @@
+package test
+
+import (
+	"testing"
+	"io/ioutil"
+	"encoding/json"
+	"encoding/hex"
+	"gobip39"
+	"gobip39/slip10"
+	"gobip39/base58"
+	"bytes"
+)
+
+/*
+	Chain[0] holds the derivation path
+	Chain[1] holds the chain code
+	Chain[2] holds the private key
+	Chain[3] holds the public key
+ */
+type Chain []string
+
+type chainVector struct {
+	Seed string `json:"seed"`
+	Chains []Chain `json:"chains"`
+}
+
+type slip10Vectors struct {
+	Ed25519 []chainVector `json:"ed25519"`
+	Secp256k1 []chainVector `json:"secp256k1"`
+	Stellar []struct {
+		Sentence string `json:"sentence"`
+		Accounts [][]string `json:"accounts"`
+	} `json:"stellar"`
+	Solana []struct {
+		Sentence string `json:"sentence"`
+		Accounts []string `json:"accounts"`
+	} `json:"solana"`
+}
+
+func readSlip10Vectors(t *testing.T) slip10Vectors {
+	file, err := ioutil.ReadFile("./slip10_vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'slip10_vectors.json':", err.Error())
+	}
+
+	var vectors slip10Vectors
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	return vectors
+}
+
+func TestSlip10_Ed25519Vectors(t *testing.T) {
+	testChainVectors(t, readSlip10Vectors(t).Ed25519, slip10.Ed25519)
+}
+
+func TestSlip10_Secp256k1Vectors(t *testing.T) {
+	testChainVectors(t, readSlip10Vectors(t).Secp256k1, slip10.Secp256k1)
+}
+
+func testChainVectors(t *testing.T, vectors []chainVector, curve slip10.Curve) {
+	for _, v := range vectors {
+		seed, _ := hex.DecodeString(v.Seed)
+
+		master, err := slip10.NewMasterKey(seed, curve)
+
+		if (err != nil) {
+			t.Fatal("Failed to generate master key:", err.Error())
+		}
+
+		for _, c := range v.Chains {
+			key, deriveErr := master.DerivePath(c[0])
+
+			if (deriveErr != nil) {
+				t.Error("Failed to derive", c[0], "\b:", deriveErr.Error())
+				continue
+			}
+
+			if chainCode := hex.EncodeToString(key.ChainCode[:]); chainCode != c[1] {
+				t.Error("Expected chain code of", c[0], chainCode, "to equal", c[1])
+			}
+
+			if privateKey := hex.EncodeToString(key.PrivateKey[:]); privateKey != c[2] {
+				t.Error("Expected private key of", c[0], privateKey, "to equal", c[2])
+			}
+
+			if publicKey := hex.EncodeToString(key.PublicKey()); publicKey != c[3] {
+				t.Error("Expected public key of", c[0], publicKey, "to equal", c[3])
+			}
+		}
+	}
+}
+
+func TestSlip10_Derive_FailsOnNonHardenedEd25519Index(t *testing.T) {
+	master, _ := slip10.NewMasterKey(make([]byte, 16), slip10.Ed25519)
+
+	if _, err := master.Derive(0); err == nil {
+		t.Error("Expected Derive to return an error for a non-hardened Ed25519 index.")
+	}
+}
+
+func TestSlip10_NewMasterKey_FailsOnInvalidSeedLength(t *testing.T) {
+	if _, err := slip10.NewMasterKey(make([]byte, slip10.MinimumSeedLength - 1), slip10.Ed25519); err == nil {
+		t.Error("Expected NewMasterKey to return an error when the seed is shorter than", slip10.MinimumSeedLength, "bytes.")
+	}
+
+	if _, err := slip10.NewMasterKey(make([]byte, slip10.MaximumSeedLength + 1), slip10.Ed25519); err == nil {
+		t.Error("Expected NewMasterKey to return an error when the seed is longer than", slip10.MaximumSeedLength, "bytes.")
+	}
+}
+
+func TestSlip10_ParsePath_FailsOnInvalidPath(t *testing.T) {
+	for _, path := range []string{"", "n/0'", "m/x'", "m/2147483648'"} {
+		if _, err := slip10.ParsePath(path); err == nil {
+			t.Error("Expected ParsePath to return an error for path", path, "\b.")
+		}
+	}
+}
+
+func TestSlip10_StellarVectors(t *testing.T) {
+	vectors := readSlip10Vectors(t)
+
+	for _, v := range vectors.Stellar {
+		seed := gobip39.GenerateBinarySeed(v.Sentence)
+
+		for i, account := range v.Accounts {
+			key, err := slip10.DeriveStellarKey(seed, uint32(i))
+
+			if (err != nil) {
+				t.Fatal("Failed to derive Stellar key:", err.Error())
+			}
+
+			if address := key.StellarAddress(); address != account[0] {
+				t.Error("Expected Stellar address", address, "to equal", account[0])
+			}
+
+			if secret := key.StellarSecret(); secret != account[1] {
+				t.Error("Expected Stellar secret", secret, "to equal", account[1])
+			}
+		}
+	}
+}
+
+func TestSlip10_SolanaVectors(t *testing.T) {
+	vectors := readSlip10Vectors(t)
+
+	for _, v := range vectors.Solana {
+		seed := gobip39.GenerateBinarySeed(v.Sentence)
+
+		for i, account := range v.Accounts {
+			key, err := slip10.DeriveSolanaKey(seed, uint32(i))
+
+			if (err != nil) {
+				t.Fatal("Failed to derive Solana key:", err.Error())
+			}
+
+			if address := key.SolanaAddress(); address != account {
+				t.Error("Expected Solana address", address, "to equal", account)
+			}
+
+			// The address is the Base58 public key, which is the tail of the keypair
+			decoded, _ := base58.Decode(key.SolanaAddress())
+
+			if (!bytes.Equal(decoded, key.SolanaKeypair()[32:])) {
+				t.Error("Expected Solana address to decode to the keypair's public key.")
+			}
+		}
+	}
+}
+
+func TestBase58_EncodeDecodeRoundTrip(t *testing.T) {
+	data := []byte{0, 0, 'h', 'e', 'l', 'l', 'o'}
+
+	encoded := base58.Encode(data)
+
+	if (encoded != "11Cn8eVZg") {
+		t.Error("Expected Base58 encoding", encoded, "to equal 11Cn8eVZg")
+	}
+
+	decoded, err := base58.Decode(encoded)
+
+	if (err != nil || !bytes.Equal(decoded, data)) {
+		t.Error("Expected Base58 decoding", decoded, "to equal", data)
+	}
+}
+
+func TestSlip10_Serialize_MatchesEnglishVectors(t *testing.T) {
+	file, err := ioutil.ReadFile("./vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'vectors.json':", err.Error())
+	}
+
+	var vectors struct {
+		Vectors []Vector `json:"english"`
+	}
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	for _, v := range vectors.Vectors {
+		seed, _ := hex.DecodeString(v[2])
+
+		master, _ := slip10.NewMasterKey(seed, slip10.Secp256k1)
+
+		if serialized, _ := master.Serialize(); serialized != v[3] {
+			t.Error("Expected serialized master key", serialized, "to equal", v[3])
+		}
+
+		parsed, parseErr := slip10.ParseExtendedKey(v[3])
+
+		if (parseErr != nil || parsed != master) {
+			t.Error("Expected", v[3], "to parse back into the master key.")
+		}
+	}
+}
+
+func TestSlip10_SerializePublic_MatchesBip32Vector(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, _ := slip10.NewMasterKey(seed, slip10.Secp256k1)
+
+	key, _ := master.DerivePath("m/0H/1")
+
+	expected := "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ"
+
+	if serialized, _ := key.SerializePublic(); serialized != expected {
+		t.Error("Expected serialized public key", serialized, "to equal", expected)
+	}
+}
@@ -0,0 +1,172 @@
+package test
+
+import (
+	"testing"
+	"io/ioutil"
+	"encoding/json"
+	"encoding/hex"
+	"strings"
+	"gobip39"
+	"gobip39/slip39"
+	"bytes"
+)
+
+// The passphrase is "TREZOR" for all SLIP-0039 vectors
+const SLIP39_PASSPHRASE = "TREZOR"
+
+/*
+	Vector[0] holds the description
+	Vector[1] holds the mnemonics
+	Vector[2] holds the master secret (empty if the mnemonics are invalid)
+
+	The first 11 vectors, including the group sharings, are taken from
+	the SLIP-0039 vectors.json. The rest are built from their shares,
+	either by changing a single field and recomputing the RS1024 checksum,
+	or by passing a subset or reordering of a group sharing's shares.
+ */
+type slip39Vector []json.RawMessage
+
+func TestSlip39Vectors(t *testing.T) {
+	file, err := ioutil.ReadFile("./slip39_vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'slip39_vectors.json':", err.Error())
+	}
+
+	var vectors []slip39Vector
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	for _, v := range vectors {
+		var description, expected string
+		var sentences []string
+
+		json.Unmarshal(v[0], &description)
+		json.Unmarshal(v[1], &sentences)
+		json.Unmarshal(v[2], &expected)
+
+		mnemonics := make([][]string, len(sentences))
+		for i, sentence := range sentences {
+			mnemonics[i] = strings.Split(sentence, " ")
+		}
+
+		secret, combineErr := slip39.CombineMnemonics(mnemonics, SLIP39_PASSPHRASE)
+
+		if (expected == "") {
+			if (combineErr == nil) {
+				t.Error(description, "\b: expected CombineMnemonics to return an error.")
+			}
+
+			continue
+		}
+
+		if (combineErr != nil) {
+			t.Error(description, "\b: failed to combine mnemonics:", combineErr.Error())
+			continue
+		}
+
+		if actual := hex.EncodeToString(secret); actual != expected {
+			t.Error(description, "\b: expected master secret", actual, "to equal", expected)
+		}
+
+		// Re-encoding each parsed share must give back the original words
+		for _, mnemonic := range mnemonics {
+			share, _ := slip39.ParseShare(mnemonic)
+
+			if words, _ := share.Words(); strings.Join(words, " ") != strings.Join(mnemonic, " ") {
+				t.Error(description, "\b: expected share to re-encode to", strings.Join(mnemonic, " "))
+			}
+		}
+	}
+}
+
+func TestSlip39_SplitEntropy_RecoversWithGroupThreshold(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(256)
+
+	groups := []slip39.Group{{Threshold: 1, Count: 1}, {Threshold: 2, Count: 3}, {Threshold: 3, Count: 5}}
+
+	shares, err := slip39.SplitEntropy(entropy, "passphrase", 2, groups, true, 0)
+
+	if (err != nil) {
+		t.Fatal("Failed to split Entropy:", err.Error())
+	}
+
+	// Two of the three groups, each meeting its member threshold
+	selected := []slip39.Share{shares[1][2], shares[1][0], shares[2][4], shares[2][1], shares[2][3]}
+
+	recovered, recoverErr := slip39.RecoverEntropy(selected, "passphrase")
+
+	if (recoverErr != nil) {
+		t.Fatal("Failed to recover Entropy:", recoverErr.Error())
+	}
+
+	if (!bytes.Equal(recovered.Data, entropy.Data) || recovered.Size != entropy.Size) {
+		t.Error("Expected recovered Entropy", recovered.Data, "to equal", entropy.Data)
+	}
+
+	// A wrong passphrase decrypts to a different secret rather than failing
+	wrong, _ := slip39.RecoverEntropy(selected, "wrong")
+
+	if (bytes.Equal(wrong.Data, entropy.Data)) {
+		t.Error("Expected a wrong passphrase to recover a different secret.")
+	}
+}
+
+func TestSlip39_Combine_FailsBelowMemberThreshold(t *testing.T) {
+	shares, _ := slip39.GenerateShares(make([]byte, 16), "", 1, []slip39.Group{{Threshold: 3, Count: 5}}, false, 0)
+
+	if _, err := slip39.Combine(shares[0][:2], ""); err == nil {
+		t.Error("Expected Combine to return an error when fewer shares than the member threshold are given.")
+	}
+}
+
+func TestSlip39_Combine_DetectsCorruptShare(t *testing.T) {
+	shares, _ := slip39.GenerateShares(make([]byte, 16), "", 1, []slip39.Group{{Threshold: 2, Count: 3}}, false, 0)
+
+	corrupt := shares[0][1]
+	corrupt.Value = append([]byte{}, corrupt.Value...)
+	corrupt.Value[0] ^= 1
+
+	if _, err := slip39.Combine([]slip39.Share{shares[0][0], corrupt}, ""); err == nil {
+		t.Error("Expected Combine to return an error when a share value is corrupt.")
+	}
+}
+
+func TestSlip39_ParseShare_AcceptsPrefixes(t *testing.T) {
+	mnemonic := strings.Split("duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard", " ")
+
+	abbreviated := make([]string, len(mnemonic))
+	for i, word := range mnemonic {
+		abbreviated[i] = word[:4]
+	}
+
+	expected, _ := slip39.ParseShare(mnemonic)
+	share, err := slip39.ParseShare(abbreviated)
+
+	if (err != nil) {
+		t.Fatal("Failed to parse abbreviated share:", err.Error())
+	}
+
+	if (!bytes.Equal(share.Value, expected.Value)) {
+		t.Error("Expected abbreviated share value", share.Value, "to equal", expected.Value)
+	}
+}
+
+func TestSlip39_ParseShare_FailsOnMisspelledWord(t *testing.T) {
+	for _, word := range []string{"duckz", "ducklinx", "ducklings", "duc"} {
+		mnemonic := strings.Split("duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard", " ")
+		mnemonic[0] = word
+
+		if _, err := slip39.ParseShare(mnemonic); err == nil {
+			t.Error("Expected ParseShare to return an error for the word", word)
+		}
+	}
+}
+
+func TestSlip39_GenerateShares_FailsOnMultipleThresholdOneMembers(t *testing.T) {
+	if _, err := slip39.GenerateShares(make([]byte, 16), "", 1, []slip39.Group{{Threshold: 1, Count: 2}}, false, 0); err == nil {
+		t.Error("Expected GenerateShares to return an error for a 1-of-2 member group.")
+	}
+}
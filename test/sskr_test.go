@@ -0,0 +1,177 @@
+package test
+
+import (
+	"testing"
+	"io/ioutil"
+	"encoding/json"
+	"encoding/hex"
+	"gobip39"
+	"gobip39/sskr"
+	"bytes"
+)
+
+type sskrShareVector struct {
+	Share string `json:"share"`
+	UR string `json:"ur"`
+	LegacyUR string `json:"legacyUR"`
+}
+
+type sskrVector struct {
+	Secret string `json:"secret"`
+	GroupThreshold byte `json:"groupThreshold"`
+	Groups [][]sskrShareVector `json:"groups"`
+}
+
+// The vectors were computed with an independent implementation of
+// BCR-2020-011, drawing its randomness from the bytes 0x00, 0x11, 0x22...
+func TestSskrVectors(t *testing.T) {
+	file, err := ioutil.ReadFile("./sskr_vectors.json")
+
+	if (err != nil) {
+		t.Fatal("Failed to read from required vector file 'sskr_vectors.json':", err.Error())
+	}
+
+	var vectors []sskrVector
+
+	if marshalErr := json.Unmarshal(file, &vectors); marshalErr != nil {
+		t.Fatal("Failed to unmarshal file data:", marshalErr.Error())
+	}
+
+	for _, v := range vectors {
+		shares := []sskr.Share{}
+
+		for _, group := range v.Groups {
+			for _, s := range group {
+				data, _ := hex.DecodeString(s.Share)
+
+				share, parseErr := sskr.ParseShare(data)
+
+				if (parseErr != nil) {
+					t.Error("Failed to parse share", s.Share, "\b:", parseErr.Error())
+					continue
+				}
+
+				if (share.GroupThreshold != v.GroupThreshold || int(share.GroupCount) != len(v.Groups)) {
+					t.Error("Expected share", s.Share, "to hold the vector's group parameters.")
+				}
+
+				if actual, _ := share.UR(); actual != s.UR {
+					t.Error("Expected share UR", actual, "to equal", s.UR)
+				}
+
+				legacy, legacyErr := sskr.ParseUR(s.LegacyUR)
+
+				if (legacyErr != nil || !bytes.Equal(legacy.Bytes(), data)) {
+					t.Error("Expected legacy UR", s.LegacyUR, "to parse into share", s.Share)
+				}
+
+				shares = append(shares, share)
+			}
+		}
+
+		secret, combineErr := sskr.CombineSecret(shares)
+
+		if (combineErr != nil) {
+			t.Error("Failed to combine shares:", combineErr.Error())
+			continue
+		}
+
+		if actual := hex.EncodeToString(secret); actual != v.Secret {
+			t.Error("Expected combined secret", actual, "to equal", v.Secret)
+		}
+	}
+}
+
+func TestSskr_GenerateAndCombineThroughEncodings(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(256)
+
+	groups := []sskr.Group{{Threshold: 2, Count: 3}, {Threshold: 1, Count: 1}, {Threshold: 3, Count: 4}}
+
+	shares, err := sskr.Generate(entropy, 2, groups)
+
+	if (err != nil) {
+		t.Fatal("Failed to generate SSKR shares:", err.Error())
+	}
+
+	// Move the first group through URs and the last through Bytewords
+	selected := []sskr.Share{}
+
+	for _, share := range []sskr.Share{shares[0][2], shares[0][0]} {
+		encoded, urErr := share.UR()
+
+		if (urErr != nil) {
+			t.Fatal("Failed to encode share as a UR:", urErr.Error())
+		}
+
+		parsed, parseErr := sskr.ParseUR(encoded)
+
+		if (parseErr != nil) {
+			t.Fatal("Failed to parse share UR", encoded, "\b:", parseErr.Error())
+		}
+
+		selected = append(selected, parsed)
+	}
+
+	for _, share := range []sskr.Share{shares[2][3], shares[2][1], shares[2][0]} {
+		parsed, parseErr := sskr.ParseBytewords(share.Bytewords())
+
+		if (parseErr != nil) {
+			t.Fatal("Failed to parse share Bytewords:", parseErr.Error())
+		}
+
+		selected = append(selected, parsed)
+	}
+
+	mnemonic, combineErr := sskr.CombineMnemonic(selected)
+
+	if (combineErr != nil) {
+		t.Fatal("Failed to combine SSKR shares:", combineErr.Error())
+	}
+
+	expected, _ := gobip39.GetMnemonicFromEntropy(entropy)
+
+	if (!bytes.Equal(mnemonic.Entropy.Data, entropy.Data) || mnemonic.Checksum != expected.Checksum) {
+		t.Error("Expected combined Mnemonic to match the original Entropy.")
+	}
+}
+
+func TestSskr_Bytes_EncodesMetadata(t *testing.T) {
+	share := sskr.Share{Identifier: 0x1234, GroupIndex: 1, GroupThreshold: 2, GroupCount: 3, MemberIndex: 4, MemberThreshold: 5, Value: make([]byte, 16)}
+
+	expected := append([]byte{0x12, 0x34, 0x12, 0x14, 0x04}, make([]byte, 16)...)
+
+	if actual := share.Bytes(); !bytes.Equal(actual, expected) {
+		t.Error("Expected serialized share", actual, "to equal", expected)
+	}
+
+	parsed, err := sskr.ParseShare(expected)
+
+	if (err != nil || parsed.Identifier != share.Identifier || parsed.MemberThreshold != share.MemberThreshold || parsed.GroupCount != share.GroupCount) {
+		t.Error("Expected serialized share to parse back into the share.")
+	}
+}
+
+func TestSskr_Combine_FailsBelowGroupThreshold(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(128)
+
+	shares, _ := sskr.Generate(entropy, 2, []sskr.Group{{Threshold: 2, Count: 2}, {Threshold: 2, Count: 2}})
+
+	// One complete group and one incomplete group
+	if _, err := sskr.Combine([]sskr.Share{shares[0][0], shares[0][1], shares[1][0]}); err == nil {
+		t.Error("Expected Combine to return an error below the group threshold.")
+	}
+}
+
+func TestSskr_Combine_DetectsCorruptShare(t *testing.T) {
+	entropy, _ := gobip39.GenerateEntropy(128)
+
+	shares, _ := sskr.Generate(entropy, 1, []sskr.Group{{Threshold: 2, Count: 3}})
+
+	corrupt := shares[0][1]
+	corrupt.Value = append([]byte{}, corrupt.Value...)
+	corrupt.Value[3] ^= 0x80
+
+	if _, err := sskr.Combine([]sskr.Share{shares[0][0], corrupt}); err == nil {
+		t.Error("Expected Combine to return an error when a share value is corrupt.")
+	}
+}
@@ -0,0 +1,256 @@
+package test
+
+import (
+	"testing"
+	"time"
+	"strings"
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"gobip39"
+	"gobip39/bytewords"
+	"gobip39/cbor"
+	"gobip39/ur"
+	"gobip39/wordlist"
+)
+
+// Examples from BCR-2020-006
+const CRYPTO_SEED_UR = "ur:crypto-seed/oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenos"
+const CRYPTO_BIP39_UR = "ur:crypto-bip39/oeadlkiyjkisinihjzieihiojpjlkpjoihihjpjlieihihhskthsjeihiejzjliajeiojkhskpjkhsioihieiahsjkisihiojzhsjpihiekthskoihieiajpihktihiyjzhsjnihihiojzjlkoihaoidihjtrkkndede"
+const CRYPTO_BIP39_SENTENCE = "shield group erode awake lock sausage cash glare wave crew flame glove"
+
+func TestUR_EncodeSeed_MatchesSpecExample(t *testing.T) {
+	data, _ := hex.DecodeString("c7098580125e2ab0981253468b2dbc52")
+	ent, _ := gobip39.GetEntropyFromBytes(data)
+	birthdate := time.Date(2020, time.May, 12, 0, 0, 0, 0, time.UTC)
+
+	resource, err := ur.EncodeSeed(ur.Seed{Entropy: ent, Birthdate: birthdate})
+
+	if (err != nil) {
+		t.Fatal("Failed to encode crypto-seed:", err.Error())
+	}
+
+	if encoded, _ := resource.String(); encoded != CRYPTO_SEED_UR {
+		t.Error("Expected crypto-seed", encoded, "to equal", CRYPTO_SEED_UR)
+	}
+
+	parsed, _ := ur.Parse(CRYPTO_SEED_UR)
+	seed, decodeErr := ur.DecodeSeed(parsed)
+
+	if (decodeErr != nil || !bytes.Equal(seed.Entropy.Data, data) || !seed.Birthdate.Equal(birthdate)) {
+		t.Error("Expected", CRYPTO_SEED_UR, "to decode to", hex.EncodeToString(data), "born", birthdate)
+	}
+}
+
+func TestUR_EncodeSeed_RoundTripsNameAndNote(t *testing.T) {
+	ent, _ := gobip39.GenerateEntropy(256)
+
+	resource, _ := ur.EncodeSeed(ur.Seed{Entropy: ent, Name: "Savings", Note: "Kept in the safe"})
+	seed, err := ur.DecodeSeed(resource)
+
+	if (err != nil || !bytes.Equal(seed.Entropy.Data, ent.Data) || seed.Name != "Savings" || seed.Note != "Kept in the safe" || !seed.Birthdate.IsZero()) {
+		t.Error("Expected crypto-seed", seed, "to round trip.")
+	}
+}
+
+func TestUR_DecodeSeed_FailsOnOtherType(t *testing.T) {
+	parsed, _ := ur.Parse(CRYPTO_BIP39_UR)
+
+	if _, err := ur.DecodeSeed(parsed); err == nil {
+		t.Error("Expected decoding a crypto-bip39 as a crypto-seed to fail.")
+	}
+}
+
+func TestUR_EncodeBIP39_MatchesSpecExample(t *testing.T) {
+	mnemonic, err := gobip39.GetMnemonicFromSentence(strings.Split(CRYPTO_BIP39_SENTENCE, " "), wordlist.English)
+
+	if (err != nil) {
+		t.Fatal("Failed to read the example sentence:", err.Error())
+	}
+
+	resource, encodeErr := ur.EncodeBIP39(mnemonic, wordlist.English)
+
+	if (encodeErr != nil) {
+		t.Fatal("Failed to encode crypto-bip39:", encodeErr.Error())
+	}
+
+	if encoded, _ := resource.String(); encoded != CRYPTO_BIP39_UR {
+		t.Error("Expected crypto-bip39", encoded, "to equal", CRYPTO_BIP39_UR)
+	}
+
+	parsed, _ := ur.Parse(CRYPTO_BIP39_UR)
+	decoded, decodeErr := ur.DecodeBIP39(parsed, wordlist.English)
+
+	if (decodeErr != nil) {
+		t.Fatal("Failed to decode crypto-bip39:", decodeErr.Error())
+	}
+
+	sentence, _ := decoded.GetSentenceFrom(wordlist.English)
+
+	if actual := strings.Join(sentence, " "); actual != CRYPTO_BIP39_SENTENCE {
+		t.Error("Expected sentence", actual, "to equal", CRYPTO_BIP39_SENTENCE)
+	}
+}
+
+func TestUR_DecodeBIP39_FailsOnHugeWordCount(t *testing.T) {
+	// The words array claims an 8 byte length far beyond the body
+	parsed, err := ur.Parse("ur:crypto-bip39/oyadndfzaeaeaeaeaeaeaetlwmoygh")
+
+	if (err != nil) {
+		t.Fatal("Failed to parse UR:", err.Error())
+	}
+
+	if _, decodeErr := ur.DecodeBIP39(parsed, wordlist.English); decodeErr == nil {
+		t.Error("Expected DecodeBIP39 to return an error for an oversized words array.")
+	}
+}
+
+// Parts of a 256 byte message from the reference implementation
+var MULTIPART_UR = []string{
+	"ur:bytes/1-9/lpadascfadaxcywenbpljkhdcahkadaemejtswhhylkepmykhhtsytsnoyoyaxaedsuttydmmhhpktpmsrjtdkgslpgh",
+	"ur:bytes/2-9/lpaoascfadaxcywenbpljkhdcagwdpfnsboxgwlbaawzuefywkdplrsrjynbvygabwjldapfcsgmghhkhstlrdcxaefz",
+	"ur:bytes/3-9/lpaxascfadaxcywenbpljkhdcahelbknlkuejnbadmssfhfrdpsbiegecpasvssovlgeykssjykklronvsjksopdzmol",
+	"ur:bytes/4-9/lpaaascfadaxcywenbpljkhdcasotkhemthydawydtaxneurlkosgwcekonertkbrlwmplssjtammdplolsbrdzcrtas",
+	"ur:bytes/5-9/lpahascfadaxcywenbpljkhdcatbbdfmssrkzmcwnezelennjpfzbgmuktrhtejscktelgfpdlrkfyfwdajldejokbwf",
+	"ur:bytes/6-9/lpamascfadaxcywenbpljkhdcackjlhkhybssklbwefectpfnbbectrljectpavyrolkzczcpkmwidmwoxkilghdsowp",
+	"ur:bytes/7-9/lpatascfadaxcywenbpljkhdcavszmwnjkwtclrtvaynhpahrtoxmwvwatmedibkaegdosftvandiodagdhthtrlnnhy",
+	"ur:bytes/8-9/lpayascfadaxcywenbpljkhdcadmsponkkbbhgsoltjntegepmttmoonftnbuoiyrehfrtsabzsttorodklubbuyaetk",
+	"ur:bytes/9-9/lpasascfadaxcywenbpljkhdcajskecpmdckihdyhphfotjojtfmlnwmadspaxrkytbztpbauotbgtgtaeaevtgavtny",
+	"ur:bytes/10-9/lpbkascfadaxcywenbpljkhdcahkadaemejtswhhylkepmykhhtsytsnoyoyaxaedsuttydmmhhpktpmsrjtwdkiplzs",
+	"ur:bytes/11-9/lpbdascfadaxcywenbpljkhdcahelbknlkuejnbadmssfhfrdpsbiegecpasvssovlgeykssjykklronvsjkvetiiapk",
+	"ur:bytes/12-9/lpbnascfadaxcywenbpljkhdcarllaluzmdmgstospeyiefmwejlwtpedamktksrvlcygmzemovovllarodtmtbnptrs",
+}
+
+// Helper method that decodes the single-fragment parts of MULTIPART_UR.
+func decodeMultipartExample(t *testing.T) ur.UR {
+	decoder := ur.NewDecoder()
+
+	for _, part := range MULTIPART_UR[:9] {
+		if err := decoder.Receive(part); err != nil {
+			t.Fatal("Failed to receive", part, ":", err.Error())
+		}
+	}
+
+	resource, err := decoder.Result()
+
+	if (err != nil) {
+		t.Fatal("Failed to decode the multipart example:", err.Error())
+	}
+
+	return resource
+}
+
+func TestUR_Encoder_MatchesReferenceParts(t *testing.T) {
+	resource := decodeMultipartExample(t)
+
+	encoder, err := ur.NewEncoder(resource, 30)
+
+	if (err != nil) {
+		t.Fatal("Failed to create Encoder:", err.Error())
+	}
+
+	if (encoder.SequenceLength() != 9) {
+		t.Error("Expected sequence length", encoder.SequenceLength(), "to equal", 9)
+	}
+
+	for _, expected := range MULTIPART_UR {
+		if actual := encoder.NextPart(); actual != expected {
+			t.Error("Expected part", actual, "to equal", expected)
+		}
+	}
+}
+
+func TestUR_Decoder_RecoversFromMixedParts(t *testing.T) {
+	resource := decodeMultipartExample(t)
+	encoder, _ := ur.NewEncoder(resource, 30)
+
+	// Drop every single-fragment part so only mixed parts arrive
+	for i := 0; i < encoder.SequenceLength(); i++ {
+		encoder.NextPart()
+	}
+
+	decoder := ur.NewDecoder()
+	received := 0
+
+	for !decoder.IsComplete() && received < 100 {
+		if err := decoder.Receive(strings.ToUpper(encoder.NextPart())); err != nil {
+			t.Fatal("Failed to receive part:", err.Error())
+		}
+
+		received++
+
+		if (!decoder.IsComplete() && decoder.EstimatedPercentComplete() >= 1) {
+			t.Error("Expected progress below 1 before completion.")
+		}
+	}
+
+	decoded, err := decoder.Result()
+
+	if (err != nil || decoded.Type != resource.Type || !bytes.Equal(decoded.CBOR, resource.CBOR)) {
+		t.Error("Expected", received, "mixed parts to decode to the original UR.")
+	}
+
+	if (decoder.EstimatedPercentComplete() != 1) {
+		t.Error("Expected progress", decoder.EstimatedPercentComplete(), "to equal", 1)
+	}
+}
+
+func TestUR_Encoder_SinglePart(t *testing.T) {
+	parsed, _ := ur.Parse(CRYPTO_SEED_UR)
+	encoder, _ := ur.NewEncoder(parsed, 1000)
+
+	if actual := encoder.NextPart(); !encoder.IsSinglePart() || actual != CRYPTO_SEED_UR {
+		t.Error("Expected single part", actual, "to equal", CRYPTO_SEED_UR)
+	}
+
+	decoder := ur.NewDecoder()
+	decoder.Receive(CRYPTO_SEED_UR)
+
+	if decoded, err := decoder.Result(); err != nil || !bytes.Equal(decoded.CBOR, parsed.CBOR) {
+		t.Error("Expected", CRYPTO_SEED_UR, "to decode in a single part.")
+	}
+}
+
+func TestUR_Decoder_RejectsPartsOfAnotherUR(t *testing.T) {
+	decoder := ur.NewDecoder()
+	decoder.Receive(MULTIPART_UR[0])
+
+	other, _ := ur.NewEncoder(ur.UR{Type: "bytes", CBOR: bytes.Repeat([]byte{0x01}, 100)}, 30)
+
+	if err := decoder.Receive(other.NextPart()); err == nil {
+		t.Error("Expected a part of another UR to be rejected.")
+	}
+
+	if (decoder.IsComplete()) {
+		t.Error("Expected decoding to remain incomplete.")
+	}
+}
+
+// Helper method that builds a multipart UR string from its fields.
+func multipartPart(sequence uint64, sequenceLength uint64, messageLength uint64, fragment []byte) string {
+	body := cbor.EncodeArray(cbor.EncodeUnsigned(sequence), cbor.EncodeUnsigned(sequenceLength), cbor.EncodeUnsigned(messageLength), cbor.EncodeUnsigned(0), cbor.EncodeBytes(fragment))
+
+	return "ur:bytes/" + strconv.FormatUint(sequence, 10) + "-" + strconv.FormatUint(sequenceLength, 10) + "/" + bytewords.Encode(body, bytewords.Minimal)
+}
+
+func TestUR_Decoder_RejectsInconsistentSequenceLength(t *testing.T) {
+	decoder := ur.NewDecoder()
+
+	// A mixed part claiming far more fragments than the message needs
+	if err := decoder.Receive(multipartPart(5, 4294967295, 30, make([]byte, 10))); err == nil {
+		t.Error("Expected a sequence length not covering the message exactly to be rejected.")
+	}
+
+	if (decoder.ExpectedPartCount() != 0) {
+		t.Error("Expected", decoder.ExpectedPartCount(), "to equal", 0)
+	}
+}
+
+func TestUR_Decoder_RejectsOversizedMessage(t *testing.T) {
+	decoder := ur.NewDecoder()
+	length := uint64(ur.MaximumMessageLength + 1)
+
+	if err := decoder.Receive(multipartPart(1, length, length, make([]byte, 1))); err == nil {
+		t.Error("Expected a message longer than", ur.MaximumMessageLength, "bytes to be rejected.")
+	}
+}
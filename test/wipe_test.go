@@ -0,0 +1,70 @@
+package test
+
+import (
+	"testing"
+	"bytes"
+	"strings"
+	"gobip39"
+	"gobip39/wordlist"
+)
+
+func TestWipe_GetMnemonicFromEntropy_DoesNotWritePastEntropy(t *testing.T) {
+	// Spare capacity past the entropy, which appending would write into
+	data := bytes.Repeat([]byte{0xAA}, 32)
+	ent, _ := gobip39.GetEntropyFromBytes(data[:16])
+	gobip39.GetMnemonicFromEntropy(ent)
+
+	if (data[16] != 0xAA) {
+		t.Error("Expected", data[16], "to equal", 0xAA)
+	}
+}
+
+func TestWipe_Entropy_Destroy_ZeroesData(t *testing.T) {
+	data := bytes.Repeat([]byte{0xAA}, 16)
+	ent, _ := gobip39.GetEntropyFromBytes(data)
+	ent.Destroy()
+
+	if (!bytes.Equal(data, make([]byte, 16)) || ent.Data != nil || ent.Size != 0) {
+		t.Error("Expected", data, ent, "to be zeroed")
+	}
+}
+
+func TestWipe_Mnemonic_Destroy_ZeroesEntropyAndIndices(t *testing.T) {
+	mnemonic, _ := gobip39.GetMnemonicFromSentence(strings.Fields("legal winner thank year wave sausage worth useful legal winner thank yellow"), wordlist.English)
+	data, indices := mnemonic.Entropy.Data, mnemonic.Sentence
+	mnemonic.Destroy()
+
+	if (!bytes.Equal(data, make([]byte, 16))) {
+		t.Error("Expected", data, "to be zeroed")
+	}
+
+	for _, index := range indices {
+		if (index != 0) {
+			t.Error("Expected", indices, "to be zeroed")
+			break
+		}
+	}
+
+	if (mnemonic.Sentence != nil || mnemonic.Checksum != 0 || mnemonic.Entropy.Data != nil) {
+		t.Error("Expected", mnemonic, "to be reset")
+	}
+}
+
+func TestWipe_Wipe_ZeroesSeed(t *testing.T) {
+	seed := gobip39.GenerateBinarySeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "TREZOR")
+	gobip39.Wipe(seed)
+
+	if (!bytes.Equal(seed, make([]byte, gobip39.KeyLengthBytes))) {
+		t.Error("Expected", seed, "to be zeroed")
+	}
+}
+
+func TestWipe_MixTranscript_Destroy_ZeroesInputs(t *testing.T) {
+	_, transcript, _ := gobip39.GenerateMixedEntropy(128, []byte("123456"), gobip39.MixHMAC)
+	random, user := transcript.Random, transcript.User
+	transcript.Destroy()
+
+	if (!bytes.Equal(random, make([]byte, 16)) || !bytes.Equal(user, make([]byte, 6))) {
+		t.Error("Expected", random, user, "to be zeroed")
+	}
+}
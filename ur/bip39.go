@@ -0,0 +1,140 @@
+package ur
+
+// This file encodes a Mnemonic's words as a crypto-bip39 Uniform Resource as detailed by
+// BCR-2020-006 spec: https://github.com/BlockchainCommons/Research/blob/master/papers/bcr-2020-006-urtypes.md
+
+import (
+	"gobip39"
+	"gobip39/cbor"
+	"gobip39/wordlist"
+)
+
+const (
+	BIP39Type = "crypto-bip39"
+	BIP39Tag uint64 = 301
+)
+
+// Map keys of a crypto-bip39
+const (
+	bip39WordsKey = 1
+	bip39LanguageKey = 2
+)
+
+// Bounds on the number of words of a BIP-0039 sentence
+const (
+	minimumSentenceWords = (gobip39.MinimumEntropySize + gobip39.MinimumChecksumSize) / gobip39.WordBitLength
+	maximumSentenceWords = (gobip39.MaximumEntropySize + gobip39.MaximumCheckSumSize) / gobip39.WordBitLength
+)
+
+// ISO 639-1 codes of BIP-0039 languages, keyed by Wordlist.Language()
+var languageCodes = map[string]string{
+	"English": "en",
+	"Japanese": "ja",
+	"Korean": "ko",
+	"Spanish": "es",
+	"Chinese (Simplified)": "zh-Hans",
+	"Chinese (Traditional)": "zh-Hant",
+	"French": "fr",
+	"Italian": "it",
+	"Czech": "cs",
+	"Portuguese": "pt",
+}
+
+// Encode the Mnemonic's sentence in a Wordlist and the Wordlist's
+// language as a crypto-bip39 UR.
+// An error is returned if the language has no code or the sentence
+// cannot be built.
+func EncodeBIP39(mnemonic gobip39.Mnemonic, wl wordlist.Wordlist) (UR, error) {
+	language, ok := languageCodes[wl.Language()]
+
+	if (!ok) {
+		return UR{}, urError{Message: "Language '" + wl.Language() + "' has no language code."}
+	}
+
+	sentence, err := mnemonic.GetSentenceFrom(wl)
+
+	if (err != nil) { return UR{}, err }
+
+	words := make([][]byte, len(sentence))
+	for i, word := range sentence {
+		words[i] = cbor.EncodeText(word)
+	}
+
+	body := cbor.EncodeMap(
+		cbor.EncodeUnsigned(bip39WordsKey), cbor.EncodeArray(words...),
+		cbor.EncodeUnsigned(bip39LanguageKey), cbor.EncodeText(language))
+
+	return UR{Type: BIP39Type, CBOR: body}, nil
+}
+
+// Decode a crypto-bip39 UR into a Mnemonic, reading its words from the
+// Wordlist matching its language (English when no language is given).
+// An error is returned if the UR has another type, its CBOR is malformed,
+// its language does not match the Wordlist, or its words are not a
+// valid mnemonic.
+func DecodeBIP39(resource UR, wl wordlist.Wordlist) (gobip39.Mnemonic, error) {
+	if (resource.Type != BIP39Type) {
+		return gobip39.Mnemonic{}, urError{Message: "Expected a UR of type '" + BIP39Type + "', got '" + resource.Type + "'."}
+	}
+
+	decoder := cbor.NewDecoder(resource.CBOR)
+
+	if err := skipTag(decoder, BIP39Tag); err != nil {
+		return gobip39.Mnemonic{}, err
+	}
+
+	pairs, err := decoder.ReadMapHead()
+
+	if (err != nil) { return gobip39.Mnemonic{}, err }
+
+	language := "en"
+	sentence := []string{}
+
+	for i := uint64(0); i < pairs; i++ {
+		key, keyErr := decoder.ReadUnsigned()
+
+		if (keyErr != nil) { return gobip39.Mnemonic{}, keyErr }
+
+		var fieldErr error
+
+		switch key {
+		case bip39WordsKey:
+			sentence, fieldErr = readWords(decoder)
+		case bip39LanguageKey:
+			language, fieldErr = decoder.ReadText()
+		default:
+			fieldErr = decoder.Skip()
+		}
+
+		if (fieldErr != nil) { return gobip39.Mnemonic{}, fieldErr }
+	}
+
+	if (languageCodes[wl.Language()] != language) {
+		return gobip39.Mnemonic{}, urError{Message: "crypto-bip39 language '" + language + "' does not match the " + wl.Language() + " wordlist."}
+	}
+
+	return gobip39.GetMnemonicFromSentence(sentence, wl)
+}
+
+// Helper method to read an array of text strings.
+// An error is returned if the array cannot hold a BIP-0039 sentence, checked
+// before allocating so a crafted length cannot exhaust memory.
+func readWords(decoder *cbor.Decoder) ([]string, error) {
+	count, err := decoder.ReadArrayHead()
+
+	if (err != nil) { return []string{}, err }
+
+	if (count < minimumSentenceWords || count > maximumSentenceWords) {
+		return []string{}, urError{Message: "crypto-bip39 must hold between 12 and 24 words."}
+	}
+
+	words := make([]string, count)
+
+	for i := range words {
+		words[i], err = decoder.ReadText()
+
+		if (err != nil) { return []string{}, err }
+	}
+
+	return words, nil
+}
@@ -0,0 +1,451 @@
+package ur
+
+// This file implements multipart Uniform Resources ("ur:type/seq-len/...")
+// built from fountain coded fragments as detailed by
+// BCR-2020-005 spec: https://github.com/BlockchainCommons/Research/blob/master/papers/bcr-2020-005-ur.md
+
+import (
+	"hash/crc32"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"gobip39/bytewords"
+	"gobip39/cbor"
+)
+
+const (
+	MinimumFragmentLength = 10
+	// Largest message a multipart UR may carry, bounding what a Decoder
+	// allocates for a part
+	MaximumMessageLength = 1 << 20
+	// Expected number of received parts per fragment, used to estimate progress
+	decodingOverhead = 1.75
+)
+
+// Type to produce the endless sequence of parts of a UR, e.g. for an
+// animated QR code. Once every fragment has been emitted on its own, the
+// Encoder emits mixes of fragments so that a Decoder can recover the UR
+// from any sufficiently large subset of parts.
+type Encoder struct {
+	resource UR
+	fragments [][]byte
+	checksum uint32
+	sequence uint32
+}
+
+// Create an Encoder splitting the UR's CBOR into fragments of at most
+// maxFragmentLength bytes.
+// An error is returned if the UR's type is invalid, its CBOR is empty or
+// longer than MaximumMessageLength, or if maxFragmentLength is below
+// MinimumFragmentLength.
+func NewEncoder(resource UR, maxFragmentLength int) (*Encoder, error) {
+	if (!IsValidType(resource.Type)) {
+		return nil, urError{Message: "Invalid UR type '" + resource.Type + "'."}
+	}
+
+	if (len(resource.CBOR) == 0) {
+		return nil, urError{Message: "UR must have a CBOR body."}
+	}
+
+	if (len(resource.CBOR) > MaximumMessageLength) {
+		return nil, urError{Message: "UR is longer than " + strconv.Itoa(MaximumMessageLength) + " bytes."}
+	}
+
+	if (maxFragmentLength < MinimumFragmentLength) {
+		return nil, urError{Message: "Fragments must be at least " + strconv.Itoa(MinimumFragmentLength) + " bytes long."}
+	}
+
+	fragmentLength := nominalFragmentLength(len(resource.CBOR), MinimumFragmentLength, maxFragmentLength)
+
+	var fragments [][]byte
+	for offset := 0; offset < len(resource.CBOR); offset += fragmentLength {
+		fragment := make([]byte, fragmentLength)
+		copy(fragment, resource.CBOR[offset:])
+		fragments = append(fragments, fragment)
+	}
+
+	return &Encoder{resource: resource, fragments: fragments, checksum: crc32.ChecksumIEEE(resource.CBOR)}, nil
+}
+
+// Number of fragments the UR was split into, which is also the least
+// number of parts needed to decode it.
+func (encoder *Encoder) SequenceLength() int {
+	return len(encoder.fragments)
+}
+
+// Whether the UR fits into a single part.
+func (encoder *Encoder) IsSinglePart() bool {
+	return len(encoder.fragments) == 1
+}
+
+// Produce the next part. A UR that fits into a single part is always
+// encoded in the single-part form "ur:type/body".
+func (encoder *Encoder) NextPart() string {
+	if (encoder.IsSinglePart()) {
+		encoded, _ := encoder.resource.String()
+		return encoded
+	}
+
+	encoder.sequence++
+
+	sequenceLength := len(encoder.fragments)
+	mixed := make([]byte, len(encoder.fragments[0]))
+
+	for _, index := range chooseFragments(encoder.sequence, sequenceLength, encoder.checksum) {
+		xorInto(mixed, encoder.fragments[index])
+	}
+
+	body := cbor.EncodeArray(
+		cbor.EncodeUnsigned(uint64(encoder.sequence)),
+		cbor.EncodeUnsigned(uint64(sequenceLength)),
+		cbor.EncodeUnsigned(uint64(len(encoder.resource.CBOR))),
+		cbor.EncodeUnsigned(uint64(encoder.checksum)),
+		cbor.EncodeBytes(mixed))
+
+	return Scheme + encoder.resource.Type + "/" + strconv.FormatUint(uint64(encoder.sequence), 10) + "-" + strconv.Itoa(sequenceLength) + "/" + bytewords.Encode(body, bytewords.Minimal)
+}
+
+// A received part reduced to the fragments it still mixes
+type mixedPart struct {
+	indices []int
+	data []byte
+}
+
+// Type to reassemble a UR from parts received in any order, with losses
+// and duplicates.
+type Decoder struct {
+	urType string
+	sequenceLength int
+	messageLength int
+	checksum uint32
+	fragmentLength int
+	fragments map[int][]byte
+	mixed map[string]mixedPart
+	queue []mixedPart
+	processedParts int
+	result *UR
+	err error
+}
+
+// Create an empty Decoder.
+func NewDecoder() *Decoder {
+	return &Decoder{fragments: map[int][]byte{}, mixed: map[string]mixedPart{}}
+}
+
+// Receive a single-part or multipart UR string. Parts received after the
+// UR is complete are ignored.
+// An error is returned if the part is malformed or inconsistent with the
+// parts received before it; such a part is otherwise ignored. An error
+// is also returned, and kept as the Decoder's result, if the reassembled
+// UR fails its checksum.
+func (decoder *Decoder) Receive(part string) error {
+	if (decoder.IsComplete()) {
+		return nil
+	}
+
+	lowered := strings.ToLower(part)
+
+	if (strings.Count(lowered, "/") == 1) {
+		resource, err := Parse(lowered)
+
+		if (err != nil) { return err }
+
+		decoder.result = &resource
+		return nil
+	}
+
+	urType, sequence, sequenceLength, body, err := parsePart(lowered)
+
+	if (err != nil) { return err }
+
+	messageLength, checksum, fragment, err := decodePart(body, sequence, sequenceLength)
+
+	if (err != nil) { return err }
+
+	if (decoder.urType == "") {
+		// The fragments must exactly cover the message, which bounds the
+		// sequence length before anything is allocated for it
+		if (messageLength == 0 || sequenceLength != (messageLength + len(fragment) - 1) / len(fragment)) {
+			return urError{Message: "Inconsistent multipart UR lengths."}
+		}
+
+		decoder.urType = urType
+		decoder.sequenceLength = sequenceLength
+		decoder.messageLength = messageLength
+		decoder.checksum = checksum
+		decoder.fragmentLength = len(fragment)
+	} else if (urType != decoder.urType || sequenceLength != decoder.sequenceLength || messageLength != decoder.messageLength || checksum != decoder.checksum || len(fragment) != decoder.fragmentLength) {
+		return urError{Message: "Part does not belong to the UR being decoded."}
+	}
+
+	decoder.queue = append(decoder.queue, mixedPart{indices: chooseFragments(sequence, sequenceLength, checksum), data: fragment})
+
+	for len(decoder.queue) > 0 && !decoder.IsComplete() {
+		next := decoder.queue[0]
+		decoder.queue = decoder.queue[1:]
+
+		if (len(next.indices) == 1) {
+			decoder.processSimplePart(next)
+		} else {
+			decoder.processMixedPart(next)
+		}
+	}
+
+	decoder.processedParts++
+
+	return decoder.err
+}
+
+// Whether the UR has been decoded, successfully or not.
+func (decoder *Decoder) IsComplete() bool {
+	return decoder.result != nil || decoder.err != nil
+}
+
+// The decoded UR.
+// An error is returned if decoding is incomplete or failed.
+func (decoder *Decoder) Result() (UR, error) {
+	if (decoder.err != nil) {
+		return UR{}, decoder.err
+	}
+
+	if (decoder.result == nil) {
+		return UR{}, urError{Message: "UR decoding is not complete."}
+	}
+
+	return *decoder.result, nil
+}
+
+// Number of fragments of the UR being decoded, or 0 before the first
+// multipart part.
+func (decoder *Decoder) ExpectedPartCount() int {
+	return decoder.sequenceLength
+}
+
+// Rough estimate of progress between 0 and 1, assuming parts arrive
+// uniformly at random. It only reaches 1 once decoding is complete.
+func (decoder *Decoder) EstimatedPercentComplete() float64 {
+	if (decoder.IsComplete()) {
+		return 1
+	}
+
+	if (decoder.sequenceLength == 0) {
+		return 0
+	}
+
+	return math.Min(0.99, float64(decoder.processedParts) / (float64(decoder.sequenceLength) * decodingOverhead))
+}
+
+// Helper method that records a single fragment, completing the UR once
+// every fragment is known or otherwise reducing the mixed parts by it.
+func (decoder *Decoder) processSimplePart(part mixedPart) {
+	index := part.indices[0]
+
+	if _, ok := decoder.fragments[index]; ok {
+		return
+	}
+
+	decoder.fragments[index] = part.data
+
+	if (len(decoder.fragments) < decoder.sequenceLength) {
+		decoder.reduceMixedParts(part)
+		return
+	}
+
+	message := make([]byte, 0, decoder.fragmentLength * decoder.sequenceLength)
+	for i := 0; i < decoder.sequenceLength; i++ {
+		message = append(message, decoder.fragments[i]...)
+	}
+	message = message[:decoder.messageLength]
+
+	if (crc32.ChecksumIEEE(message) != decoder.checksum) {
+		decoder.err = urError{Message: "Multipart UR failed its checksum."}
+		return
+	}
+
+	decoder.result = &UR{Type: decoder.urType, CBOR: message}
+}
+
+// Helper method that reduces a mixed part by every known part, keeping it
+// if it still mixes several fragments.
+func (decoder *Decoder) processMixedPart(part mixedPart) {
+	if _, ok := decoder.mixed[indicesKey(part.indices)]; ok {
+		return
+	}
+
+	for index, fragment := range decoder.fragments {
+		part = reducePart(part, mixedPart{indices: []int{index}, data: fragment})
+	}
+
+	for _, other := range decoder.mixed {
+		part = reducePart(part, other)
+	}
+
+	if (len(part.indices) == 1) {
+		decoder.queue = append(decoder.queue, part)
+		return
+	}
+
+	decoder.reduceMixedParts(part)
+	decoder.mixed[indicesKey(part.indices)] = part
+}
+
+// Helper method that reduces every stored mixed part by a part, queueing
+// those left with a single fragment.
+func (decoder *Decoder) reduceMixedParts(by mixedPart) {
+	reduced := map[string]mixedPart{}
+
+	for _, part := range decoder.mixed {
+		part = reducePart(part, by)
+
+		if (len(part.indices) == 1) {
+			decoder.queue = append(decoder.queue, part)
+		} else {
+			reduced[indicesKey(part.indices)] = part
+		}
+	}
+
+	decoder.mixed = reduced
+}
+
+// Helper method that removes the fragments of b from a when a mixes all
+// of them.
+func reducePart(a mixedPart, b mixedPart) mixedPart {
+	remaining := map[int]bool{}
+	for _, index := range a.indices {
+		remaining[index] = true
+	}
+
+	for _, index := range b.indices {
+		if (!remaining[index]) {
+			return a
+		}
+
+		delete(remaining, index)
+	}
+
+	if (len(remaining) == 0) {
+		return a
+	}
+
+	indices := make([]int, 0, len(remaining))
+	for index := range remaining {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+
+	data := append([]byte{}, a.data...)
+	xorInto(data, b.data)
+
+	return mixedPart{indices: indices, data: data}
+}
+
+// Helper method that builds a map key from a set of indices.
+func indicesKey(indices []int) string {
+	sorted := append([]int{}, indices...)
+	sort.Ints(sorted)
+
+	key := make([]string, len(sorted))
+	for i, index := range sorted {
+		key[i] = strconv.Itoa(index)
+	}
+
+	return strings.Join(key, ",")
+}
+
+func xorInto(target []byte, data []byte) {
+	for i := range target {
+		target[i] ^= data[i]
+	}
+}
+
+// Helper method that splits "ur:type/seq-len/body" into its components.
+func parsePart(encoded string) (string, uint32, int, []byte, error) {
+	if (!strings.HasPrefix(encoded, Scheme)) {
+		return "", 0, 0, nil, urError{Message: "UR must begin with 'ur:'."}
+	}
+
+	components := strings.Split(encoded[len(Scheme):], "/")
+
+	if (len(components) != 3) {
+		return "", 0, 0, nil, urError{Message: "Expected a multipart UR of the form 'ur:type/seq-len/body'."}
+	}
+
+	if (!IsValidType(components[0])) {
+		return "", 0, 0, nil, urError{Message: "Invalid UR type '" + components[0] + "'."}
+	}
+
+	numbers := strings.Split(components[1], "-")
+
+	if (len(numbers) != 2) {
+		return "", 0, 0, nil, urError{Message: "Invalid multipart UR sequence '" + components[1] + "'."}
+	}
+
+	sequence, sequenceErr := strconv.ParseUint(numbers[0], 10, 32)
+	sequenceLength, lengthErr := strconv.ParseUint(numbers[1], 10, 32)
+
+	if (sequenceErr != nil || lengthErr != nil || sequence == 0 || sequenceLength == 0) {
+		return "", 0, 0, nil, urError{Message: "Invalid multipart UR sequence '" + components[1] + "'."}
+	}
+
+	body, err := bytewords.Decode(components[2], bytewords.Minimal)
+
+	if (err != nil) { return "", 0, 0, nil, err }
+
+	return components[0], uint32(sequence), int(sequenceLength), body, nil
+}
+
+// Helper method that decodes the CBOR of a part, checking it agrees with
+// the sequence in its UR string.
+func decodePart(body []byte, sequence uint32, sequenceLength int) (int, uint32, []byte, error) {
+	decoder := cbor.NewDecoder(body)
+	fields, err := decoder.ReadArrayHead()
+
+	if (err != nil) { return 0, 0, nil, err }
+
+	if (fields != 5) {
+		return 0, 0, nil, urError{Message: "Multipart UR body must hold 5 fields."}
+	}
+
+	var numbers [4]uint64
+	for i := range numbers {
+		numbers[i], err = decoder.ReadUnsigned()
+
+		if (err != nil) { return 0, 0, nil, err }
+	}
+
+	fragment, err := decoder.ReadBytes()
+
+	if (err != nil) { return 0, 0, nil, err }
+
+	if (numbers[0] != uint64(sequence) || numbers[1] != uint64(sequenceLength)) {
+		return 0, 0, nil, urError{Message: "Multipart UR body does not match its sequence."}
+	}
+
+	if (numbers[2] > MaximumMessageLength || numbers[3] > math.MaxUint32 || len(fragment) == 0) {
+		return 0, 0, nil, urError{Message: "Invalid multipart UR body."}
+	}
+
+	return int(numbers[2]), uint32(numbers[3]), fragment, nil
+}
+
+// Helper method that picks the fragment length giving the fewest
+// fragments of at most maxLength bytes.
+func nominalFragmentLength(messageLength int, minLength int, maxLength int) int {
+	maxCount := messageLength / minLength
+
+	if (maxCount < 1) {
+		maxCount = 1
+	}
+
+	length := messageLength
+	for count := 1; count <= maxCount; count++ {
+		length = (messageLength + count - 1) / count
+
+		if (length <= maxLength) {
+			break
+		}
+	}
+
+	return length
+}
@@ -0,0 +1,167 @@
+package ur
+
+// This file encodes Entropy as a crypto-seed Uniform Resource as detailed by
+// BCR-2020-006 spec: https://github.com/BlockchainCommons/Research/blob/master/papers/bcr-2020-006-urtypes.md
+
+import (
+	"time"
+	"gobip39"
+	"gobip39/cbor"
+)
+
+const (
+	SeedType = "crypto-seed"
+	SeedTag uint64 = 300
+	// Type used after the registry dropped the "crypto-" prefix
+	ModernSeedType = "seed"
+	ModernSeedTag uint64 = 40300
+	dateTag uint64 = 100
+	epochTag uint64 = 1
+	secondsPerDay = 24 * 60 * 60
+)
+
+// Map keys of a crypto-seed
+const (
+	seedPayloadKey = 1
+	seedBirthdateKey = 2
+	seedNameKey = 3
+	seedNoteKey = 4
+)
+
+// Type to wrap the contents of a crypto-seed. Birthdate is the day the
+// seed was created (the zero time if unknown); Name and Note are
+// optional labels.
+type Seed struct {
+	Entropy gobip39.Entropy
+	Birthdate time.Time
+	Name string
+	Note string
+}
+
+// Encode the seed as a crypto-seed UR. The birthdate is stored in whole
+// days since the Unix epoch.
+// An error is returned if the Entropy is invalid.
+func EncodeSeed(seed Seed) (UR, error) {
+	if _, err := gobip39.GetEntropyFromBytes(seed.Entropy.Data); err != nil {
+		return UR{}, urError{Message: err.Error()}
+	}
+
+	fields := [][]byte{cbor.EncodeUnsigned(seedPayloadKey), cbor.EncodeBytes(seed.Entropy.Data)}
+
+	if (!seed.Birthdate.IsZero()) {
+		days := seed.Birthdate.Unix() / secondsPerDay
+
+		if (days < 0) {
+			return UR{}, urError{Message: "Birthdate must not be before the Unix epoch."}
+		}
+
+		fields = append(fields, cbor.EncodeUnsigned(seedBirthdateKey), cbor.EncodeTag(dateTag, cbor.EncodeUnsigned(uint64(days))))
+	}
+
+	if (seed.Name != "") {
+		fields = append(fields, cbor.EncodeUnsigned(seedNameKey), cbor.EncodeText(seed.Name))
+	}
+
+	if (seed.Note != "") {
+		fields = append(fields, cbor.EncodeUnsigned(seedNoteKey), cbor.EncodeText(seed.Note))
+	}
+
+	return UR{Type: SeedType, CBOR: cbor.EncodeMap(fields...)}, nil
+}
+
+// Decode a crypto-seed (or "seed") UR. Birthdates tagged as days
+// (tag 100) or as epoch seconds (tag 1) are accepted.
+// An error is returned if the UR has another type, its CBOR is
+// malformed, or its payload is not a valid Entropy.
+func DecodeSeed(resource UR) (Seed, error) {
+	if (resource.Type != SeedType && resource.Type != ModernSeedType) {
+		return Seed{}, urError{Message: "Expected a UR of type '" + SeedType + "', got '" + resource.Type + "'."}
+	}
+
+	decoder := cbor.NewDecoder(resource.CBOR)
+
+	if err := skipTag(decoder, SeedTag, ModernSeedTag); err != nil {
+		return Seed{}, err
+	}
+
+	pairs, err := decoder.ReadMapHead()
+
+	if (err != nil) { return Seed{}, err }
+
+	var seed Seed
+	var payload []byte
+
+	for i := uint64(0); i < pairs; i++ {
+		key, keyErr := decoder.ReadUnsigned()
+
+		if (keyErr != nil) { return Seed{}, keyErr }
+
+		var fieldErr error
+
+		switch key {
+		case seedPayloadKey:
+			payload, fieldErr = decoder.ReadBytes()
+		case seedBirthdateKey:
+			seed.Birthdate, fieldErr = readDate(decoder)
+		case seedNameKey:
+			seed.Name, fieldErr = decoder.ReadText()
+		case seedNoteKey:
+			seed.Note, fieldErr = decoder.ReadText()
+		default:
+			fieldErr = decoder.Skip()
+		}
+
+		if (fieldErr != nil) { return Seed{}, fieldErr }
+	}
+
+	if (payload == nil) {
+		return Seed{}, urError{Message: "crypto-seed is missing its payload."}
+	}
+
+	seed.Entropy, err = gobip39.GetEntropyFromBytes(append([]byte{}, payload...))
+
+	if (err != nil) { return Seed{}, urError{Message: err.Error()} }
+
+	return seed, nil
+}
+
+// Helper method to read a date tagged as days since the epoch or
+// as epoch seconds.
+func readDate(decoder *cbor.Decoder) (time.Time, error) {
+	tag, err := decoder.ReadTag()
+
+	if (err != nil) { return time.Time{}, err }
+
+	value, valueErr := decoder.ReadUnsigned()
+
+	if (valueErr != nil) { return time.Time{}, valueErr }
+
+	switch tag {
+	case dateTag:
+		return time.Unix(int64(value) * secondsPerDay, 0).UTC(), nil
+	case epochTag:
+		return time.Unix(int64(value), 0).UTC(), nil
+	}
+
+	return time.Time{}, urError{Message: "Unsupported CBOR date tag."}
+}
+
+// Helper method to skip an optional leading tag, which must be one
+// of the accepted tags when present.
+func skipTag(decoder *cbor.Decoder, accepted ...uint64) error {
+	if major, _ := decoder.PeekMajor(); major != cbor.Tag {
+		return nil
+	}
+
+	tag, err := decoder.ReadTag()
+
+	if (err != nil) { return err }
+
+	for _, acceptedTag := range accepted {
+		if (tag == acceptedTag) {
+			return nil
+		}
+	}
+
+	return urError{Message: "Unexpected CBOR tag."}
+}
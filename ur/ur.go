@@ -0,0 +1,83 @@
+package ur
+
+// This file implements single-part Uniform Resources ("ur:type/...") as detailed by
+// BCR-2020-005 spec: https://github.com/BlockchainCommons/Research/blob/master/papers/bcr-2020-005-ur.md
+
+import (
+	"strings"
+	"gobip39/bytewords"
+)
+
+const (
+	Scheme = "ur:"
+)
+
+// Error type specifically for UR errors
+type urError struct {
+	Message string
+}
+
+func (err urError) Error() string {
+	return err.Message
+}
+
+// Type to wrap a Uniform Resource: its type and CBOR encoded body.
+type UR struct {
+	Type string
+	CBOR []byte
+}
+
+// Encode the UR as "ur:type/" followed by its body in minimal Bytewords.
+// An error is returned if the type is invalid.
+func (resource UR) String() (string, error) {
+	if (!IsValidType(resource.Type)) {
+		return "", urError{Message: "Invalid UR type '" + resource.Type + "'."}
+	}
+
+	return Scheme + resource.Type + "/" + bytewords.Encode(resource.CBOR, bytewords.Minimal), nil
+}
+
+// Parse a single-part UR string. Parsing is case-insensitive so that
+// URs from uppercase (alphanumeric mode) QR codes are accepted.
+// An error is returned if the scheme or type is invalid, the UR is a
+// multipart fragment (see Decoder), or its body fails to decode.
+func Parse(encoded string) (UR, error) {
+	encoded = strings.ToLower(encoded)
+
+	if (!strings.HasPrefix(encoded, Scheme)) {
+		return UR{}, urError{Message: "UR must begin with 'ur:'."}
+	}
+
+	components := strings.Split(encoded[len(Scheme):], "/")
+
+	if (len(components) != 2) {
+		return UR{}, urError{Message: "Expected a single-part UR of the form 'ur:type/body'."}
+	}
+
+	if (!IsValidType(components[0])) {
+		return UR{}, urError{Message: "Invalid UR type '" + components[0] + "'."}
+	}
+
+	body, err := bytewords.Decode(components[1], bytewords.Minimal)
+
+	if (err != nil) { return UR{}, err }
+
+	return UR{Type: components[0], CBOR: body}, nil
+}
+
+// Whether a UR type only holds lowercase letters, digits and hyphens.
+func IsValidType(urType string) bool {
+	if (len(urType) == 0) {
+		return false
+	}
+
+	for i := 0; i < len(urType); i++ {
+		character := urType[i]
+
+		if (!(character >= 'a' && character <= 'z') && !(character >= '0' && character <= '9') && character != '-') {
+			return false
+		}
+	}
+
+	return true
+}
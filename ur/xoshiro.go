@@ -0,0 +1,163 @@
+package ur
+
+// This file implements the deterministic fragment choice of multipart URs
+// (Xoshiro256** seeded by SHA-256 and an alias sampler) as detailed by
+// BCR-2020-005 spec: https://github.com/BlockchainCommons/Research/blob/master/papers/bcr-2020-005-ur.md
+
+import (
+	SHA256 "crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// Xoshiro256** state
+type xoshiro256 struct {
+	state [4]uint64
+}
+
+// Seed a generator with the SHA-256 digest of the seed, read as four
+// big-endian words.
+func newXoshiro256(seed []byte) *xoshiro256 {
+	digest := SHA256.Sum256(seed)
+	rng := &xoshiro256{}
+
+	for i := range rng.state {
+		rng.state[i] = binary.BigEndian.Uint64(digest[i * 8:])
+	}
+
+	return rng
+}
+
+func (rng *xoshiro256) next() uint64 {
+	s := &rng.state
+	result := bits.RotateLeft64(s[1] * 5, 7) * 9
+	t := s[1] << 17
+
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = bits.RotateLeft64(s[3], 45)
+
+	return result
+}
+
+// Uniform double in [0, 1).
+func (rng *xoshiro256) nextDouble() float64 {
+	return float64(rng.next()) / 18446744073709551616.0
+}
+
+// Uniform integer in [low, high].
+func (rng *xoshiro256) nextInt(low int, high int) int {
+	return int(rng.nextDouble() * float64(high - low + 1)) + low
+}
+
+// Helper method that shuffles items, drawing each next item from the
+// remaining ones.
+func (rng *xoshiro256) shuffled(items []int) []int {
+	remaining := append([]int{}, items...)
+	result := make([]int, 0, len(items))
+
+	for len(remaining) > 0 {
+		index := rng.nextInt(0, len(remaining) - 1)
+		result = append(result, remaining[index])
+		remaining = append(remaining[:index], remaining[index + 1:]...)
+	}
+
+	return result
+}
+
+// Walker's alias method for sampling weighted indices
+type randomSampler struct {
+	probabilities []float64
+	aliases []int
+}
+
+func newRandomSampler(weights []float64) randomSampler {
+	count := len(weights)
+	sum := 0.0
+
+	for _, weight := range weights {
+		sum += weight
+	}
+
+	scaled := make([]float64, count)
+	for i, weight := range weights {
+		scaled[i] = weight * float64(count) / sum
+	}
+
+	// Indices are visited in reverse, as in the reference implementation
+	small := []int{}
+	large := []int{}
+
+	for i := count - 1; i >= 0; i-- {
+		if (scaled[i] < 1) {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	sampler := randomSampler{probabilities: make([]float64, count), aliases: make([]int, count)}
+
+	for len(small) > 0 && len(large) > 0 {
+		less := small[len(small) - 1]
+		small = small[:len(small) - 1]
+		greater := large[len(large) - 1]
+		large = large[:len(large) - 1]
+
+		sampler.probabilities[less] = scaled[less]
+		sampler.aliases[less] = greater
+		scaled[greater] += scaled[less] - 1
+
+		if (scaled[greater] < 1) {
+			small = append(small, greater)
+		} else {
+			large = append(large, greater)
+		}
+	}
+
+	// Leftovers, in exact arithmetic only ever large
+	for _, index := range append(large, small...) {
+		sampler.probabilities[index] = 1
+	}
+
+	return sampler
+}
+
+func (sampler randomSampler) next(rng *xoshiro256) int {
+	r1 := rng.nextDouble()
+	r2 := rng.nextDouble()
+	index := int(float64(len(sampler.probabilities)) * r1)
+
+	if (r2 < sampler.probabilities[index]) {
+		return index
+	}
+
+	return sampler.aliases[index]
+}
+
+// Choose the fragment indices mixed into a part. The first sequenceLength
+// parts carry one fragment each; later parts mix a pseudo-random set of
+// fragments whose size is drawn with weight 1/size.
+func chooseFragments(sequence uint32, sequenceLength int, checksum uint32) []int {
+	if (int(sequence) <= sequenceLength) {
+		return []int{int(sequence) - 1}
+	}
+
+	seed := binary.BigEndian.AppendUint32(binary.BigEndian.AppendUint32(nil, sequence), checksum)
+	rng := newXoshiro256(seed)
+
+	weights := make([]float64, sequenceLength)
+	indices := make([]int, sequenceLength)
+
+	for i := range weights {
+		weights[i] = 1 / float64(i + 1)
+		indices[i] = i
+	}
+
+	degree := newRandomSampler(weights).next(rng) + 1
+
+	return rng.shuffled(indices)[:degree]
+}
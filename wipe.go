@@ -0,0 +1,63 @@
+package gobip39
+
+// This file zeroes secret material once it is no longer needed. Go's
+// garbage collector may move or copy memory, so zeroing is best effort,
+// but it keeps secrets from lingering in buffers this package owns.
+//
+// The following return memory that the caller owns and must wipe:
+// - Entropy.Data from GenerateEntropy, GetEntropyFromBytes (which keeps
+//   the given slice) and the other Entropy constructors: Entropy.Destroy
+// - Mnemonic from GetMnemonicFromEntropy and the other Mnemonic
+//   constructors, whose Entropy shares Data with the Entropy given:
+//   Mnemonic.Destroy
+// - The seed from GenerateBinarySeed: Wipe
+// - MixTranscript from GenerateMixedEntropy: MixTranscript.Destroy
+// Sentences from GetSentenceFrom and the strings passed to
+// GenerateBinarySeed are immutable Go strings and cannot be wiped.
+
+import (
+	"runtime"
+)
+
+// Zero data in place, such as the seed returned by GenerateBinarySeed.
+func Wipe(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+
+	runtime.KeepAlive(data)
+}
+
+// Helper method that zeroes word indices in place.
+func wipeIndices(indices []uint32) {
+	for i := range indices {
+		indices[i] = 0
+	}
+
+	runtime.KeepAlive(indices)
+}
+
+// Zero the Entropy's Data in place and reset the Entropy. Any Mnemonic
+// made from the Entropy shares its Data, so is wiped as well.
+func (ent *Entropy) Destroy() {
+	Wipe(ent.Data)
+	ent.Data = nil
+	ent.Size = 0
+}
+
+// Zero the Mnemonic's Entropy, checksum and word indices in place and
+// reset the Mnemonic.
+func (mnemonic *Mnemonic) Destroy() {
+	mnemonic.Entropy.Destroy()
+	wipeIndices(mnemonic.Sentence)
+	mnemonic.Sentence = nil
+	mnemonic.Checksum = 0
+}
+
+// Zero the transcript's inputs and Entropy in place.
+func (transcript *MixTranscript) Destroy() {
+	Wipe(transcript.Random)
+	Wipe(transcript.User)
+	transcript.Entropy.Destroy()
+	transcript.Random, transcript.User = nil, nil
+}